@@ -1,30 +1,99 @@
 package main
 
 import (
-	"strings"
+	"bytes"
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
 
+	"github.com/stephenwithav/sqlc/pkg/config"
+	"github.com/stephenwithav/sqlc/pkg/diagnostic"
+	"github.com/stephenwithav/sqlc/pkg/format"
 	"github.com/stephenwithav/sqlc/pkg/generator"
 )
 
 func main() {
-	test := `-- name: GetAuthor :one
-SELECT * FROM authors
-WHERE id = $1 LIMIT 1;
-
--- name: ListAuthors :many
-SELECT * FROM authors
-ORDER BY name;
-
--- name: CreateAuthor :one
-INSERT INTO authors (
-  name, bio
-) VALUES (
-  $1, $2
-)
-RETURNING *;
+	file := flag.String("f", config.DefaultFilename, "configuration file")
+	diagnostics := flag.String("diagnostics", "text", "error output format: text, json or sarif")
+	flag.Parse()
+
+	var err error
+	switch cmd := flag.Arg(0); cmd {
+	case "", "generate":
+		err = run(*file, *diagnostics)
+	case "fmt":
+		err = runFmt(*file)
+	default:
+		err = fmt.Errorf("unknown command: %s", cmd)
+		fmt.Fprintln(os.Stderr, err)
+	}
+	if err != nil {
+		os.Exit(1)
+	}
+}
+
+// runFmt rewrites the inline SQL in the configuration file in canonical form.
+func runFmt(file string) error {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return err
+	}
+	out, err := format.Config(data)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return err
+	}
+	if bytes.Equal(data, out) {
+		return nil
+	}
+	if err := os.WriteFile(file, out, 0644); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return err
+	}
+	return nil
+}
+
+func run(file, format string) error {
+	f, err := diagnostic.ParseFormat(format)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return err
+	}
+	in, err := os.Open(file)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return err
+	}
+	defer in.Close()
+
+	output, _, err := generator.Generate(context.Background(), in, &generator.Option{
+		DiagnosticFormat: f,
+		ConfigPath:       file,
+	})
+	if err != nil {
+		return err
+	}
 
--- name: DeleteAuthor :exec
-DELETE FROM authors
-WHERE id = $1;`
-	generator.SQLToGo(strings.NewReader(test))
+	dir := filepath.Dir(file)
+	names := make([]string, 0, len(output))
+	for name := range output {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return err
+		}
+		if err := os.WriteFile(path, []byte(output[name]), 0644); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return err
+		}
+	}
+	return nil
 }
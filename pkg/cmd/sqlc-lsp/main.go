@@ -0,0 +1,17 @@
+// sqlc-lsp runs the sqlc language server over stdin and stdout.
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/stephenwithav/sqlc/pkg/lsp"
+)
+
+func main() {
+	if err := lsp.Serve(context.Background(), os.Stdin, os.Stdout); err != nil {
+		fmt.Fprintf(os.Stderr, "sqlc-lsp: %s\n", err)
+		os.Exit(1)
+	}
+}
@@ -17,6 +17,8 @@ type Enum struct {
 	Name      string
 	Comment   string
 	Constants []Constant
+	// IsSet is true for the members of a MySQL SET column
+	IsSet bool
 }
 
 func EnumReplace(value string) string {
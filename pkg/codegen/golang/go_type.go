@@ -25,6 +25,11 @@ func addExtraGoStructTags(tags map[string]string, req *plugin.CodeGenRequest, co
 	}
 }
 
+// GoType returns the Go type generated for col.
+func GoType(req *plugin.CodeGenRequest, col *plugin.Column) string {
+	return goType(req, col)
+}
+
 func goType(req *plugin.CodeGenRequest, col *plugin.Column) string {
 	// Check if the column's type has been overridden
 	for _, oride := range req.Settings.Overrides {
@@ -49,6 +54,12 @@ func goType(req *plugin.CodeGenRequest, col *plugin.Column) string {
 func goInnerType(req *plugin.CodeGenRequest, col *plugin.Column) string {
 	columnType := sdk.DataType(col.Type)
 	notNull := col.NotNull || col.IsArray
+	if req.Settings.Engine == "postgresql" {
+		// A NOT NULL domain never holds null
+		if d := postgresDomain(req, col); d != nil && d.NotNull {
+			notNull = true
+		}
+	}
 
 	// package overrides have a higher precedence
 	for _, oride := range req.Settings.Overrides {
@@ -264,6 +264,11 @@ func (i *importer) modelImports() fileImports {
 		std["fmt"] = struct{}{}
 		std["database/sql/driver"] = struct{}{}
 	}
+	for _, enum := range i.Enums {
+		if enum.IsSet {
+			std["strings"] = struct{}{}
+		}
+	}
 
 	return sortedImports(std, pkg)
 }
@@ -367,6 +372,13 @@ func (i *importer) queryImports(filename string) fileImports {
 		std["context"] = struct{}{}
 	}
 
+	// Reading user variables pins a connection of the *sql.DB
+	for _, q := range gq {
+		if q.OutVarsSQL != "" {
+			std["database/sql"] = struct{}{}
+		}
+	}
+
 	sqlpkg := parseDriver(i.Settings.Go.SqlPackage)
 	if sliceScan() && !sqlpkg.IsPGX() {
 		pkg[ImportSpec{Path: "github.com/lib/pq"}] = struct{}{}
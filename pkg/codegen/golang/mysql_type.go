@@ -57,7 +57,7 @@ func mysqlType(req *plugin.CodeGenRequest, col *plugin.Column) string {
 		}
 		return "sql.NullFloat64"
 
-	case "decimal", "dec", "fixed":
+	case "decimal", "dec", "fixed", "numeric":
 		if notNull {
 			return "string"
 		}
@@ -67,6 +67,30 @@ func mysqlType(req *plugin.CodeGenRequest, col *plugin.Column) string {
 		// TODO: Proper Enum support
 		return "string"
 
+	case "set":
+		if col.Table != nil {
+			name := col.Table.Name + "_" + col.Name
+			for _, schema := range req.Catalog.Schemas {
+				for _, enum := range schema.Enums {
+					if enum.Name != name {
+						continue
+					}
+					typeName := StructName(enum.Name, req.Settings) + "Set"
+					if schema.Name != req.Catalog.DefaultSchema {
+						typeName = StructName(schema.Name+"_"+enum.Name, req.Settings) + "Set"
+					}
+					if notNull {
+						return typeName
+					}
+					return "Null" + typeName
+				}
+			}
+		}
+		if notNull {
+			return "string"
+		}
+		return "sql.NullString"
+
 	case "date", "timestamp", "datetime", "time":
 		if notNull {
 			return "time.Time"
@@ -33,6 +33,25 @@ func parseIdentifierString(name string) (*plugin.Identifier, error) {
 	}
 }
 
+// postgresDomain returns the domain a column is declared with, or nil
+func postgresDomain(req *plugin.CodeGenRequest, col *plugin.Column) *plugin.Domain {
+	rel, err := parseIdentifierString(sdk.DataType(col.Type))
+	if err != nil {
+		return nil
+	}
+	if rel.Schema == "" {
+		rel.Schema = req.Catalog.DefaultSchema
+	}
+	for _, schema := range req.Catalog.Schemas {
+		for _, d := range schema.Domains {
+			if rel.Name == d.Name && rel.Schema == schema.Name {
+				return d
+			}
+		}
+	}
+	return nil
+}
+
 func postgresType(req *plugin.CodeGenRequest, col *plugin.Column) string {
 	columnType := sdk.DataType(col.Type)
 	notNull := col.NotNull || col.IsArray
@@ -524,6 +543,18 @@ func postgresType(req *plugin.CodeGenRequest, col *plugin.Column) string {
 		return "interface{}"
 
 	default:
+		if d := postgresDomain(req, col); d != nil {
+			// A domain has the Go type of its base type, which can be
+			// overridden separately
+			return goType(req, &plugin.Column{
+				Name:    col.Name,
+				Table:   col.Table,
+				Type:    d.Type,
+				NotNull: notNull || d.NotNull,
+				IsArray: d.IsArray,
+				Length:  col.Length,
+			})
+		}
 		rel, err := parseIdentifierString(columnType)
 		if err != nil {
 			// TODO: Should this actually return an error here?
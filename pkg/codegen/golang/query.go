@@ -162,6 +162,8 @@ type Query struct {
 	Arg          QueryValue
 	// Used for :copyfrom
 	Table *plugin.Identifier
+	// Reads the user variables assigned by a MySQL CALL statement
+	OutVarsSQL string
 }
 
 func (q Query) hasRetType() bool {
@@ -12,6 +12,7 @@ import (
 )
 
 func buildEnums(req *plugin.CodeGenRequest) []Enum {
+	sets := setEnums(req)
 	var enums []Enum
 	for _, schema := range req.Catalog.Schemas {
 		if schema.Name == "pg_catalog" || schema.Name == "information_schema" {
@@ -27,6 +28,7 @@ func buildEnums(req *plugin.CodeGenRequest) []Enum {
 			e := Enum{
 				Name:    StructName(enumName, req.Settings),
 				Comment: enum.Comment,
+				IsSet:   sets[enum.Name],
 			}
 			seen := make(map[string]struct{}, len(enum.Vals))
 			for i, v := range enum.Vals {
@@ -50,6 +52,25 @@ func buildEnums(req *plugin.CodeGenRequest) []Enum {
 	return enums
 }
 
+// setEnums returns the names of the enums which hold the members of MySQL
+// SET columns. They are named after the table and the column.
+func setEnums(req *plugin.CodeGenRequest) map[string]bool {
+	sets := map[string]bool{}
+	if req.Settings.Engine != "mysql" {
+		return sets
+	}
+	for _, schema := range req.Catalog.Schemas {
+		for _, table := range schema.Tables {
+			for _, column := range table.Columns {
+				if sdk.DataType(column.Type) == "set" {
+					sets[table.Rel.Name+"_"+column.Name] = true
+				}
+			}
+		}
+	}
+	return sets
+}
+
 func buildStructs(req *plugin.CodeGenRequest) []Struct {
 	var structs []Struct
 	for _, schema := range req.Catalog.Schemas {
@@ -162,6 +183,16 @@ func buildQueries(req *plugin.CodeGenRequest, structs []Struct) ([]Query, error)
 		}
 		sqlpkg := parseDriver(req.Settings.Go.SqlPackage)
 
+		// MySQL procedures return their OUT arguments in the user variables
+		// passed in their place, which are read back after the call
+		if req.Settings.Engine == "mysql" && query.Cmd == metadata.CmdOne && isCallStmt(query.Text) && len(query.Columns) > 0 {
+			var vars []string
+			for _, c := range query.Columns {
+				vars = append(vars, "@"+c.Name)
+			}
+			gq.OutVarsSQL = "SELECT " + strings.Join(vars, ", ")
+		}
+
 		if len(query.Params) == 1 {
 			p := query.Params[0]
 			gq.Arg = QueryValue{
@@ -356,3 +387,8 @@ func checkIncompatibleFieldTypes(fields []Field) error {
 	}
 	return nil
 }
+
+func isCallStmt(sql string) bool {
+	fields := strings.Fields(sql)
+	return len(fields) > 0 && strings.EqualFold(fields[0], "call")
+}
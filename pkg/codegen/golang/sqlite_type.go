@@ -67,6 +67,33 @@ func sqliteType(req *plugin.CodeGenRequest, col *plugin.Column) string {
 		}
 		return "sql.NullFloat64"
 
+	// The remaining rules follow the column affinity rules of SQLite
+	// https://www.sqlite.org/datatype3.html#determination_of_column_affinity
+	case strings.Contains(dt, "int"):
+		if notNull {
+			return "int64"
+		}
+		return "sql.NullInt64"
+
+	case strings.Contains(dt, "char"),
+		strings.Contains(dt, "clob"),
+		strings.Contains(dt, "text"):
+		if notNull {
+			return "string"
+		}
+		return "sql.NullString"
+
+	case strings.Contains(dt, "blob"):
+		return "[]byte"
+
+	case strings.Contains(dt, "real"),
+		strings.Contains(dt, "floa"),
+		strings.Contains(dt, "doub"):
+		if notNull {
+			return "float64"
+		}
+		return "sql.NullFloat64"
+
 	default:
 		log.Printf("unknown SQLite type: %s\n", dt)
 		return "interface{}"
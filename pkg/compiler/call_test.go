@@ -0,0 +1,32 @@
+package compiler
+
+import (
+	"testing"
+
+	"github.com/stephenwithav/sqlc/pkg/config"
+)
+
+func TestCallStmt(t *testing.T) {
+	testQueries(t, "", []queryTest{
+		{
+			engine: config.EnginePostgreSQL,
+			schema: "CREATE PROCEDURE totals(IN lo integer, INOUT n bigint, OUT total numeric) LANGUAGE sql AS $$ SELECT 1 $$;",
+			query:  "CALL totals($1, $2, NULL)",
+			cols:   []column{{"n", "pg_catalog.int8", false}, {"total", "pg_catalog.numeric", false}},
+			params: []column{{"lo", "pg_catalog.int4", true}, {"n", "pg_catalog.int8", true}},
+		},
+		{
+			engine: config.EnginePostgreSQL,
+			schema: "CREATE PROCEDURE touch(id integer) LANGUAGE sql AS $$ SELECT 1 $$;",
+			query:  "CALL touch($1)",
+			params: []column{{"id", "pg_catalog.int4", true}},
+		},
+		{
+			engine: config.EngineMySQL,
+			schema: "CREATE TABLE t (id int);",
+			query:  "CALL totals(?, @n, @total)",
+			cols:   []column{{"n", "any", false}, {"total", "any", false}},
+			params: []column{{"totals", "any", true}},
+		},
+	})
+}
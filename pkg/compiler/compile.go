@@ -4,9 +4,12 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"path/filepath"
 	"regexp"
 	"strings"
 
+	"github.com/stephenwithav/sqlc/pkg/config"
+	"github.com/stephenwithav/sqlc/pkg/engine/sqlite"
 	"github.com/stephenwithav/sqlc/pkg/metadata"
 	"github.com/stephenwithav/sqlc/pkg/migrations"
 	"github.com/stephenwithav/sqlc/pkg/multierr"
@@ -22,6 +25,12 @@ type Parser interface {
 	IsReservedKeyword(string) bool
 }
 
+// Formatter is implemented by parsers which can print a single statement in
+// canonical form.
+type Formatter interface {
+	Format(sql string) (string, error)
+}
+
 // copied over from gen.go
 func structName(name string) string {
 	out := ""
@@ -53,16 +62,32 @@ func enumValueName(value string) string {
 func (c *Compiler) parseCatalog(schemas []string) error {
 	// schemas[0] contains the schemas
 	merr := multierr.New()
-	for _, schema := range schemas {
+	for j, schema := range schemas {
+		src := c.conf.SchemaSource(j)
+		name := schema
+		if src != nil && src.Database {
+			// Errors are reported against the statements read from the
+			// database file
+			path := schema
+			if !filepath.IsAbs(path) {
+				path = filepath.Join(c.combo.Global.Dir(), path)
+			}
+			ddl, err := sqlite.ReadSchema(path)
+			if err != nil {
+				merr.Add(name, "", 0, err)
+				continue
+			}
+			schema, src = ddl, nil
+		}
 		contents := migrations.RemoveRollbackStatements(schema)
 		stmts, err := c.parser.Parse(strings.NewReader(contents))
 		if err != nil {
-			merr.Add(schema, contents, 0, err)
+			c.addError(merr, src, name, contents, 0, err)
 			continue
 		}
 		for i := range stmts {
 			if err := c.catalog.Update(stmts[i], c); err != nil {
-				merr.Add(schema, contents, stmts[i].Pos(), err)
+				c.addError(merr, src, name, contents, stmts[i].Pos(), err)
 				continue
 			}
 		}
@@ -73,15 +98,29 @@ func (c *Compiler) parseCatalog(schemas []string) error {
 	return nil
 }
 
+// addError records err, translating its position inside the inline SQL text
+// into a position in the configuration file when the entry's source is known.
+func (c *Compiler) addError(merr *multierr.Error, src *config.Source, text, in string, loc int, err error) {
+	if src == nil {
+		merr.Add(text, in, loc, err)
+		return
+	}
+	merr.Add(c.combo.Global.Filename(), in, loc, err)
+	errs := merr.Errs()
+	fileErr := errs[len(errs)-1]
+	fileErr.Line, fileErr.Column = src.Translate(fileErr.Line, fileErr.Column)
+}
+
 func (c *Compiler) parseQueries(o opts.Parser) (*Result, error) {
 	var q []*Query
 	merr := multierr.New()
 	set := map[string]struct{}{}
-	for _, queryFromYaml := range c.conf.Queries {
+	for j, queryFromYaml := range c.conf.Queries {
 		src := string(queryFromYaml)
+		pos := c.conf.QueriesSource(j)
 		stmts, err := c.parser.Parse(strings.NewReader(src))
 		if err != nil {
-			merr.Add(queryFromYaml, src, 0, err)
+			c.addError(merr, pos, queryFromYaml, src, 0, err)
 			continue
 		}
 		for _, stmt := range stmts {
@@ -95,12 +134,12 @@ func (c *Compiler) parseQueries(o opts.Parser) (*Result, error) {
 				if errors.As(err, &e) && e.Location != 0 {
 					loc = e.Location
 				}
-				merr.Add(queryFromYaml, src, loc, err)
+				c.addError(merr, pos, queryFromYaml, src, loc, err)
 				continue
 			}
 			if query.Name != "" {
 				if _, exists := set[query.Name]; exists {
-					merr.Add(queryFromYaml, src, stmt.Raw.Pos(), fmt.Errorf("duplicate query name: %s", query.Name))
+					c.addError(merr, pos, queryFromYaml, src, stmt.Raw.Pos(), fmt.Errorf("duplicate query name: %s", query.Name))
 					continue
 				}
 				set[query.Name] = struct{}{}
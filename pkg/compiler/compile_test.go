@@ -0,0 +1,109 @@
+package compiler
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/stephenwithav/sqlc/pkg/config"
+	"github.com/stephenwithav/sqlc/pkg/multierr"
+	"github.com/stephenwithav/sqlc/pkg/opts"
+)
+
+func TestInlineErrorFilename(t *testing.T) {
+	for _, tc := range []struct {
+		path string
+		want string
+	}{
+		{"", config.DefaultFilename},
+		{"db/sqlc.json", "db/sqlc.json"},
+	} {
+		c := NewCompiler(config.SQL{
+			Engine:        config.EnginePostgreSQL,
+			SchemaSources: []config.Source{{Line: 4, Column: 13}},
+		}, config.CombinedSettings{Global: config.Config{Path: tc.path}})
+		err := c.ParseCatalog([]string{"ALTER TABLE books ADD COLUMN title text;"})
+		merr, ok := err.(*multierr.Error)
+		if !ok || len(merr.Errs()) != 1 {
+			t.Fatalf("expected one error; got %v", err)
+		}
+		fileErr := merr.Errs()[0]
+		if fileErr.Filename != tc.want || fileErr.Line != 4 {
+			t.Errorf("error reported at %s:%d; want %s:4", fileErr.Filename, fileErr.Line, tc.want)
+		}
+	}
+}
+
+// column is what most tests compare of the output columns and parameters of
+// a query.
+type column struct {
+	Name     string
+	DataType string
+	NotNull  bool
+}
+
+type queryTest struct {
+	engine   config.Engine
+	database string
+	schema   string
+	query    string
+	cols     []column
+	params   []column
+	err      string
+}
+
+// compileQuery compiles a query, named Q, against a schema.
+func compileQuery(conf config.SQL, schema, query string) (*Query, error) {
+	conf.Queries = []string{"-- name: Q :exec\n" + query + ";"}
+	c := NewCompiler(conf, config.CombinedSettings{})
+	if err := c.ParseCatalog([]string{schema}); err != nil {
+		return nil, err
+	}
+	if err := c.ParseQueries(nil, opts.Parser{}); err != nil {
+		return nil, err
+	}
+	return c.Result().Queries[0], nil
+}
+
+// testQueries compiles each query against the schema shared by the tests
+// followed by its own, and compares its output columns and parameters, or
+// its error. Queries default to PostgreSQL.
+func testQueries(t *testing.T, schema string, tests []queryTest) {
+	t.Helper()
+	for _, tc := range tests {
+		tc := tc
+		engine := tc.engine
+		if engine == "" {
+			engine = config.EnginePostgreSQL
+		}
+		t.Run(string(engine)+" "+tc.query, func(t *testing.T) {
+			query, err := compileQuery(config.SQL{Engine: engine, Database: tc.database}, schema+tc.schema, tc.query)
+			if tc.err != "" {
+				merr, ok := err.(*multierr.Error)
+				if !ok || len(merr.Errs()) != 1 {
+					t.Fatalf("expected error %q, got %v", tc.err, err)
+				}
+				if got := merr.Errs()[0].Err.Error(); got != tc.err {
+					t.Errorf("expected error %q, got %q", tc.err, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			var cols, params []column
+			for _, col := range query.Columns {
+				cols = append(cols, column{col.Name, col.DataType, col.NotNull})
+			}
+			for _, p := range query.Params {
+				params = append(params, column{p.Column.Name, p.Column.DataType, p.Column.NotNull})
+			}
+			if diff := cmp.Diff(tc.cols, cols); diff != "" {
+				t.Errorf("columns differ (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.params, params); diff != "" {
+				t.Errorf("parameters differ (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,48 @@
+package compiler
+
+import (
+	"testing"
+
+	"github.com/stephenwithav/sqlc/pkg/config"
+)
+
+func TestMySQLDatabases(t *testing.T) {
+	testQueries(t, "", []queryTest{
+		{
+			engine:   config.EngineMySQL,
+			database: "app",
+			schema:   "CREATE TABLE users (id int NOT NULL, name text);",
+			query:    "SELECT app.users.id, COUNT(*) AS n FROM users GROUP BY id",
+			cols:     []column{{"id", "int", true}, {"n", "bigint", true}},
+		},
+		{
+			engine: config.EngineMySQL,
+			schema: "CREATE DATABASE app; USE app; CREATE TABLE users (id int NOT NULL, name text);",
+			query:  "SELECT name FROM users WHERE id = ?",
+			cols:   []column{{"name", "text", false}},
+			params: []column{{"id", "int", true}},
+		},
+		{
+			engine: config.EngineMySQL,
+			schema: `CREATE DATABASE other;
+			CREATE TABLE other.accounts (id int NOT NULL, name text NOT NULL);
+			CREATE TABLE users (id int NOT NULL, account_id int NOT NULL);`,
+			query:  "SELECT users.id, other.accounts.name FROM users JOIN other.accounts ON other.accounts.id = users.account_id WHERE other.accounts.name = ?",
+			cols:   []column{{"id", "int", true}, {"name", "text", true}},
+			params: []column{{"name", "text", true}},
+		},
+		{
+			engine: config.EngineMySQL,
+			schema: "CREATE DATABASE other; CREATE TABLE other.accounts (id int NOT NULL, name text NOT NULL);",
+			query:  "UPDATE other.accounts SET name = ? WHERE id = ?",
+			params: []column{{"name", "text", true}, {"id", "int", true}},
+		},
+		{
+			engine: config.EngineMySQL,
+			schema: "CREATE TABLE users (id int NOT NULL);",
+			query:  "SELECT TABLE_NAME, COLUMN_NAME FROM INFORMATION_SCHEMA.COLUMNS WHERE TABLE_SCHEMA = ?",
+			cols:   []column{{"table_name", "varchar", true}, {"column_name", "varchar", false}},
+			params: []column{{"table_schema", "varchar", true}},
+		},
+	})
+}
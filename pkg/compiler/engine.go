@@ -23,11 +23,13 @@ func NewCompiler(conf config.SQL, combo config.CombinedSettings) *Compiler {
 	c := &Compiler{conf: conf, combo: combo}
 	switch conf.Engine {
 	case config.EngineSQLite:
-		c.parser = sqlite.NewParser()
+		p := sqlite.NewParser()
+		p.NullablePrimaryKeys = conf.NullablePrimaryKeys
+		c.parser = p
 		c.catalog = sqlite.NewCatalog()
 	case config.EngineMySQL:
 		c.parser = dolphin.NewParser()
-		c.catalog = dolphin.NewCatalog()
+		c.catalog = dolphin.NewCatalog(conf.Database)
 	case config.EnginePostgreSQL:
 		c.parser = postgresql.NewParser()
 		c.catalog = postgresql.NewCatalog()
@@ -41,6 +43,10 @@ func (c *Compiler) Catalog() *catalog.Catalog {
 	return c.catalog
 }
 
+func (c *Compiler) Parser() Parser {
+	return c.parser
+}
+
 func (c *Compiler) ParseCatalog(schema []string) error {
 	return c.parseCatalog(schema)
 }
@@ -0,0 +1,452 @@
+package compiler
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/stephenwithav/sqlc/pkg/sql/ast"
+	"github.com/stephenwithav/sqlc/pkg/sql/astutils"
+	"github.com/stephenwithav/sqlc/pkg/sql/catalog"
+	"github.com/stephenwithav/sqlc/pkg/sql/lang"
+)
+
+// exprColumn returns an unnamed column describing the value of an
+// expression. The column of a reference to a table is returned as is.
+// Unless stated otherwise, an expression is null when any of its operands
+// are.
+func exprColumn(qc *QueryCatalog, tables []*Table, stmt ast.Node, node ast.Node) (*Column, error) {
+	switch n := node.(type) {
+
+	case *ast.A_Const:
+		switch n.Val.(type) {
+		case *ast.Integer:
+			return &Column{DataType: "int", NotNull: true}, nil
+		case *ast.Float:
+			return &Column{DataType: "numeric", NotNull: true}, nil
+		case *ast.String:
+			return &Column{DataType: "text", NotNull: true}, nil
+		case *ast.Null:
+			return &Column{DataType: "any"}, nil
+		}
+
+	case *ast.ParamRef:
+		// Nothing is known about a parameter, and it is not treated as
+		// making the expression null. A column computed from a parameter,
+		// such as $1::text, has always been generated as a non-null type,
+		// and its value is chosen by the caller.
+		return &Column{DataType: "any", NotNull: true}, nil
+
+	case *ast.ColumnRef:
+		if hasStarRef(n) {
+			break
+		}
+		columns, err := outputColumnRefs(&ast.ResTarget{}, tables, n)
+		if err != nil {
+			// The reference may be to a column of an enclosing query
+			return &Column{DataType: "any"}, nil
+		}
+		col := columns[0]
+		if col.NotNull && isOuterJoined(stmt, col) {
+			col.NotNull = false
+		}
+		return col, nil
+
+	case *ast.TypeCast:
+		if n.TypeName == nil {
+			return nil, errors.New("no type name type cast")
+		}
+		arg, err := exprColumn(qc, tables, stmt, n.Arg)
+		if err != nil {
+			return nil, err
+		}
+		col := toColumn(n.TypeName)
+		col.NotNull = arg.NotNull
+		return col, nil
+
+	case *ast.FuncCall:
+		return funcColumn(qc, tables, stmt, n)
+
+	case *ast.A_Expr:
+		return exprOperator(qc, tables, stmt, n)
+
+	case *ast.BoolExpr:
+		args, err := exprColumns(qc, tables, stmt, n.Args)
+		if err != nil {
+			return nil, err
+		}
+		return &Column{DataType: "bool", NotNull: allNotNull(args)}, nil
+
+	case *ast.In, *ast.BetweenExpr:
+		var operands []ast.Node
+		if in, ok := n.(*ast.In); ok {
+			operands = append([]ast.Node{in.Expr}, in.List...)
+		} else {
+			b := n.(*ast.BetweenExpr)
+			operands = []ast.Node{b.Expr, b.Left, b.Right}
+		}
+		args, err := exprColumns(qc, tables, stmt, &ast.List{Items: operands})
+		if err != nil {
+			return nil, err
+		}
+		return &Column{DataType: "bool", NotNull: allNotNull(args)}, nil
+
+	case *ast.CollateClause:
+		return exprColumn(qc, tables, stmt, n.Arg)
+
+	case *ast.NullTest, *ast.BooleanTest:
+		return &Column{DataType: "bool", NotNull: true}, nil
+
+	case *ast.ScalarArrayOpExpr:
+		args, err := exprColumns(qc, tables, stmt, n.Args)
+		if err != nil {
+			return nil, err
+		}
+		return &Column{DataType: "bool", NotNull: allNotNull(args)}, nil
+
+	case *ast.SubLink:
+		switch n.SubLinkType {
+		case ast.EXISTS_SUBLINK:
+			return &Column{DataType: "bool", NotNull: true}, nil
+		case ast.ANY_SUBLINK, ast.ALL_SUBLINK:
+			// The subquery may return a null
+			return &Column{DataType: "bool"}, nil
+		case ast.EXPR_SUBLINK:
+			subcols, err := outputColumns(qc, n.Subselect)
+			if err != nil {
+				return nil, err
+			}
+			col := *subcols[0]
+			return &col, nil
+		}
+
+	case *ast.CaseExpr:
+		var results []ast.Node
+		for _, item := range n.Args.Items {
+			if when, ok := item.(*ast.CaseWhen); ok {
+				results = append(results, when.Result)
+			}
+		}
+		hasDefault := n.Defresult != nil
+		if _, ok := n.Defresult.(*ast.TODO); ok {
+			hasDefault = false
+		}
+		if hasDefault {
+			results = append(results, n.Defresult)
+		}
+		args, err := exprColumns(qc, tables, stmt, &ast.List{Items: results})
+		if err != nil {
+			return nil, err
+		}
+		return &Column{
+			DataType: resultType(results, args),
+			NotNull:  hasDefault && allNotNull(args),
+		}, nil
+
+	case *ast.CoalesceExpr:
+		args, err := exprColumns(qc, tables, stmt, n.Args)
+		if err != nil {
+			return nil, err
+		}
+		// The column of the first reference keeps its name, and its type
+		// unless the other arguments are of a wider type
+		typ := resultType(n.Args.Items, args)
+		var col *Column
+		for _, arg := range args {
+			if arg.Table != nil {
+				col = arg
+				break
+			}
+		}
+		switch {
+		case col == nil:
+			col = &Column{DataType: typ}
+		case typ != "any" && !lang.SameType(typ, col.DataType):
+			col.DataType = typ
+			col.Type = nil
+			col.Length = nil
+		}
+		col.NotNull = anyNotNull(args)
+		col.skipTableRequiredCheck = true
+		return col, nil
+
+	case *ast.MinMaxExpr:
+		args, err := exprColumns(qc, tables, stmt, n.Args)
+		if err != nil {
+			return nil, err
+		}
+		// GREATEST and LEAST ignore null arguments
+		return &Column{DataType: resultType(n.Args.Items, args), NotNull: anyNotNull(args)}, nil
+
+	case *ast.A_ArrayExpr:
+		args, err := exprColumns(qc, tables, stmt, n.Elements)
+		if err != nil {
+			return nil, err
+		}
+		return &Column{DataType: commonType(args), NotNull: true, IsArray: true}, nil
+
+	case *ast.ArrayExpr:
+		args, err := exprColumns(qc, tables, stmt, n.Elements)
+		if err != nil {
+			return nil, err
+		}
+		return &Column{DataType: commonType(args), NotNull: true, IsArray: true}, nil
+
+	case *ast.RowExpr:
+		return &Column{DataType: "any", NotNull: true}, nil
+
+	case *ast.SelectStmt:
+		subcols, err := outputColumns(qc, n)
+		if err != nil {
+			return nil, err
+		}
+		col := *subcols[0]
+		return &col, nil
+	}
+	return &Column{DataType: "any"}, nil
+}
+
+// funcColumn returns the column of a function call. Of the overloads of
+// the function, the one declared with the types of the arguments is
+// preferred, and a polymorphic result takes the type of the arguments.
+func funcColumn(qc *QueryCatalog, tables []*Table, stmt ast.Node, n *ast.FuncCall) (*Column, error) {
+	fun, err := qc.catalog.ResolveFuncCall(n)
+	if err != nil {
+		return &Column{DataType: "any", IsFuncCall: true}, nil
+	}
+	args, err := exprColumns(qc, tables, stmt, n.Args)
+	if err != nil {
+		return nil, err
+	}
+	fun = funcOverload(qc, n, fun, args, "")
+
+	col := &Column{
+		DataType:   dataType(fun.ReturnType),
+		NotNull:    !fun.ReturnTypeNullable,
+		IsFuncCall: true,
+	}
+	if ret := col.DataType; lang.IsPolymorphicType(ret) {
+		col.DataType = "any"
+		for i, arg := range fun.InArgs() {
+			if i >= len(args) || !lang.IsPolymorphicType(dataType(arg.Type)) || knownElemType(args[i]) == "" {
+				continue
+			}
+			col.DataType = knownElemType(args[i])
+			col.IsArray = lang.IsPolymorphicArrayType(ret)
+			break
+		}
+	}
+	if n.Over != nil && lang.IsNullableWindowFunction(n.Func.Name) {
+		col.NotNull = false
+	}
+	if _, ok := n.AggFilter.(*ast.TODO); n.AggFilter != nil && !ok && lang.IsNullableAggregate(n.Func.Name) {
+		col.NotNull = false
+	}
+	return col, nil
+}
+
+// funcOverload returns the overload of a function which best matches the
+// types of the arguments and, when it is known, the type of the result.
+func funcOverload(qc *QueryCatalog, n *ast.FuncCall, fun *catalog.Function, args []*Column, result string) *catalog.Function {
+	funs, err := qc.catalog.ListFuncsByName(n.Func)
+	if err != nil {
+		return fun
+	}
+	best := -1
+	for i := range funs {
+		score := overloadScore(&funs[i], args)
+		if score < 0 {
+			continue
+		}
+		if result != "" && funs[i].ReturnType != nil && lang.SameType(dataType(funs[i].ReturnType), result) {
+			score += len(args) + 1
+		}
+		if score > best {
+			fun, best = &funs[i], score
+		}
+	}
+	return fun
+}
+
+// overloadScore returns the number of arguments which have the declared
+// type of the function argument, or -1 if the function can not be called
+// with the arguments.
+func overloadScore(fun *catalog.Function, args []*Column) int {
+	params := fun.InArgs()
+	if len(params) < len(args) {
+		return -1
+	}
+	for _, param := range params[len(args):] {
+		if !param.HasDefault && param.Mode != ast.FuncParamVariadic {
+			return -1
+		}
+	}
+	score := 0
+	for i, arg := range args {
+		typ := dataType(params[i].Type)
+		elem := strings.TrimSuffix(typ, "[]")
+		switch {
+		case typ == "any" || arg.DataType == "any":
+		case lang.IsPolymorphicType(typ):
+			if arg.IsArray != lang.IsPolymorphicArrayType(typ) {
+				return -1
+			}
+		case arg.IsArray != (elem != typ || isArray(params[i].Type)):
+			return -1
+		case lang.SameType(elem, arg.DataType):
+			score++
+		default:
+			return -1
+		}
+	}
+	return score
+}
+
+// knownElemType returns the data type of a column, or of the elements of an
+// array column, or the empty string if it is not known.
+func knownElemType(col *Column) string {
+	if col.DataType == "any" {
+		return ""
+	}
+	return col.DataType
+}
+
+// exprOperator returns the column of an operator expression.
+func exprOperator(qc *QueryCatalog, tables []*Table, stmt ast.Node, n *ast.A_Expr) (*Column, error) {
+	var operands []ast.Node
+	if n.Lexpr != nil {
+		operands = append(operands, n.Lexpr)
+	}
+	if list, ok := n.Rexpr.(*ast.List); ok {
+		operands = append(operands, list.Items...)
+	} else if n.Rexpr != nil {
+		operands = append(operands, n.Rexpr)
+	}
+	args, err := exprColumns(qc, tables, stmt, &ast.List{Items: operands})
+	if err != nil {
+		return nil, err
+	}
+	notNull := allNotNull(args)
+
+	op := astutils.Join(n.Name, "")
+	switch n.Kind {
+	case ast.A_Expr_Kind_DISTINCT, ast.A_Expr_Kind_NOT_DISTINCT:
+		return &Column{DataType: "bool", NotNull: true}, nil
+	case ast.A_Expr_Kind_NULLIF:
+		return &Column{DataType: args[0].DataType}, nil
+	case ast.A_Expr_Kind_OP_ANY, ast.A_Expr_Kind_OP_ALL, ast.A_Expr_Kind_IN,
+		ast.A_Expr_Kind_LIKE, ast.A_Expr_Kind_ILIKE, ast.A_Expr_Kind_SIMILAR,
+		ast.A_Expr_Kind_BETWEEN, ast.A_Expr_Kind_NOT_BETWEEN,
+		ast.A_Expr_Kind_BETWEEN_SYM, ast.A_Expr_Kind_NOT_BETWEEN_SYM:
+		return &Column{DataType: "bool", NotNull: notNull}, nil
+	}
+	if lang.IsComparisonOperator(op) || (len(args) == 2 && lang.IsRegexMatchOperator(op)) {
+		return &Column{DataType: "bool", NotNull: notNull}, nil
+	}
+
+	var left, right string
+	if len(args) == 2 {
+		left, right = operandType(args[0]), operandType(args[1])
+	} else if len(args) == 1 {
+		right = operandType(args[0])
+	}
+	typ := "any"
+	if o, err := qc.catalog.ResolveOperator(op, left, right); err == nil {
+		typ = dataType(o.ReturnType)
+	} else if lang.IsMathematicalOperator(op) && (left == "" || left == "any") && right == "any" {
+		// Nothing is known about either operand
+		typ = "int"
+	}
+	col := &Column{DataType: typ, NotNull: notNull}
+	if lang.IsConcatOperator(op) {
+		for _, arg := range args {
+			if arg.IsArray {
+				col.DataType = arg.DataType
+				col.IsArray = true
+			}
+		}
+	}
+	return col, nil
+}
+
+func exprColumns(qc *QueryCatalog, tables []*Table, stmt ast.Node, list *ast.List) ([]*Column, error) {
+	var cols []*Column
+	if list == nil {
+		return cols, nil
+	}
+	for _, item := range list.Items {
+		col, err := exprColumn(qc, tables, stmt, item)
+		if err != nil {
+			return nil, err
+		}
+		cols = append(cols, col)
+	}
+	return cols, nil
+}
+
+// operandType returns the type of an operand of an operator, or "any" if it
+// is not known. Arrays are not described by the type of their elements.
+func operandType(col *Column) string {
+	if col.IsArray || col.DataType == "" {
+		return "any"
+	}
+	return col.DataType
+}
+
+// knownType returns the data type of a column, or the empty string if it is
+// not known.
+func knownType(col *Column) string {
+	if col.DataType == "any" || col.IsArray {
+		return ""
+	}
+	return col.DataType
+}
+
+func commonType(cols []*Column) string {
+	typ := ""
+	for _, col := range cols {
+		typ = lang.CommonType(typ, knownType(col))
+	}
+	if typ == "" {
+		return "any"
+	}
+	return typ
+}
+
+// resultType returns the common type of the expressions which may be the
+// result of a CASE, COALESCE, GREATEST or LEAST. NULL and parameters take
+// the type of the others, but a value of unknown type makes it unknown.
+func resultType(nodes []ast.Node, cols []*Column) string {
+	for i, col := range cols {
+		if col.DataType != "any" || i >= len(nodes) {
+			continue
+		}
+		switch n := nodes[i].(type) {
+		case *ast.ParamRef:
+			continue
+		case *ast.A_Const:
+			if _, ok := n.Val.(*ast.Null); ok {
+				continue
+			}
+		}
+		return "any"
+	}
+	return commonType(cols)
+}
+
+func allNotNull(cols []*Column) bool {
+	for _, col := range cols {
+		if !col.NotNull {
+			return false
+		}
+	}
+	return true
+}
+
+func anyNotNull(cols []*Column) bool {
+	for _, col := range cols {
+		if col.NotNull {
+			return true
+		}
+	}
+	return false
+}
@@ -66,9 +66,18 @@ func (p paramSearch) Visit(node ast.Node) astutils.Visitor {
 	case *ast.BetweenExpr:
 		p.parent = node
 
+	case *ast.BoolExpr:
+		p.parent = node
+
 	case *ast.CallStmt:
 		p.parent = n.FuncCall
 
+	case *ast.CaseExpr:
+		p.parent = node
+
+	case *ast.CoalesceExpr:
+		p.parent = node
+
 	case *ast.FuncCall:
 		p.parent = node
 
@@ -130,12 +139,18 @@ func (p paramSearch) Visit(node ast.Node) astutils.Visitor {
 			p.seen[ref.Location] = struct{}{}
 		}
 
+	case *ast.MinMaxExpr:
+		p.parent = node
+
 	case *ast.RangeVar:
 		p.rangeVar = n
 
 	case *ast.ResTarget:
 		p.parent = node
 
+	case *ast.RowExpr:
+		p.parent = node
+
 	case *ast.SelectStmt:
 		if n.LimitCount != nil {
 			p.limitCount = n.LimitCount
@@ -147,6 +162,9 @@ func (p paramSearch) Visit(node ast.Node) astutils.Visitor {
 	case *ast.TypeCast:
 		p.parent = node
 
+	case *ast.WindowDef:
+		p.parent = node
+
 	case *ast.ParamRef:
 		parent := p.parent
 
@@ -0,0 +1,57 @@
+package compiler
+
+import (
+	"fmt"
+
+	"github.com/stephenwithav/sqlc/pkg/sql/ast"
+	"github.com/stephenwithav/sqlc/pkg/sql/catalog"
+	"github.com/stephenwithav/sqlc/pkg/sql/sqlerr"
+)
+
+// checkGenerated returns an error if an INSERT or UPDATE assigns a value to
+// a generated column.
+func checkGenerated(c *catalog.Catalog, stmt ast.Node) error {
+	var rv *ast.RangeVar
+	var targets *ast.List
+	verb := ""
+	switch n := stmt.(type) {
+	case *ast.InsertStmt:
+		rv, targets, verb = n.Relation, n.Cols, "INSERT into"
+	case *ast.UpdateStmt:
+		if n.Relations == nil || len(n.Relations.Items) == 0 {
+			return nil
+		}
+		rv, _ = n.Relations.Items[0].(*ast.RangeVar)
+		targets, verb = n.TargetList, "UPDATE"
+	}
+	if rv == nil || rv.Relname == nil || targets == nil {
+		return nil
+	}
+	fqn, err := ParseTableName(rv)
+	if err != nil {
+		return err
+	}
+	table, err := c.GetTable(fqn)
+	if err != nil {
+		// Unknown tables are reported when the parameters are resolved
+		return nil
+	}
+	generated := map[string]bool{}
+	for _, col := range table.Columns {
+		if col.IsGenerated {
+			generated[col.Name] = true
+		}
+	}
+	for _, item := range targets.Items {
+		target, ok := item.(*ast.ResTarget)
+		if !ok || target.Name == nil || !generated[*target.Name] {
+			continue
+		}
+		return &sqlerr.Error{
+			Code:     "428C9",
+			Message:  fmt.Sprintf("cannot %s generated column \"%s\"", verb, *target.Name),
+			Location: target.Location,
+		}
+	}
+	return nil
+}
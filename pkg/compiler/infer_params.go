@@ -0,0 +1,616 @@
+package compiler
+
+import (
+	"strings"
+
+	"github.com/stephenwithav/sqlc/pkg/sql/ast"
+	"github.com/stephenwithav/sqlc/pkg/sql/astutils"
+	"github.com/stephenwithav/sqlc/pkg/sql/catalog"
+	"github.com/stephenwithav/sqlc/pkg/sql/lang"
+	"github.com/stephenwithav/sqlc/pkg/sql/named"
+)
+
+// inferredParameter returns a parameter whose column is inferred from the
+// expression around it. A numbered parameter used more than once is
+// inferred from the first of its occurrences which has a known type.
+func inferredParameter(qc *QueryCatalog, root ast.Node, ref paramRef, params *named.ParamSet) Parameter {
+	col := &Column{DataType: "any"}
+	for _, occurrence := range paramOccurrences(root, ref.ref) {
+		if expected := inferParam(qc, root, occurrence); expected != nil {
+			c := *expected
+			col = &c
+			break
+		}
+	}
+	defaultP := named.NewInferredParam(col.Name, col.NotNull)
+	p, isNamed := params.FetchMerge(ref.ref.Number, defaultP)
+	col.Name = p.Name()
+	col.NotNull = p.NotNull()
+	col.IsNamedParam = isNamed
+	return Parameter{Number: ref.ref.Number, Column: col}
+}
+
+// paramOccurrences returns the parameter followed by the other references
+// in the statement which share its number.
+func paramOccurrences(root ast.Node, ref *ast.ParamRef) []*ast.ParamRef {
+	refs := []*ast.ParamRef{ref}
+	if ref.Number == 0 {
+		return refs
+	}
+	found := astutils.Search(root, func(node ast.Node) bool {
+		other, ok := node.(*ast.ParamRef)
+		return ok && other != ref && other.Number == ref.Number
+	})
+	for _, node := range found.Items {
+		refs = append(refs, node.(*ast.ParamRef))
+	}
+	return refs
+}
+
+// unifyNamedParams gives the same column to the occurrences of a named
+// parameter which an engine without numbered parameters binds separately.
+// An occurrence which may be null makes every occurrence nullable, and an
+// occurrence of unknown type takes the type of the others when they agree.
+func unifyNamedParams(params []Parameter) {
+	names := map[string][]*Column{}
+	for _, p := range params {
+		if p.Column != nil && p.Column.IsNamedParam {
+			names[p.Column.Name] = append(names[p.Column.Name], p.Column)
+		}
+	}
+	for _, cols := range names {
+		if len(cols) < 2 {
+			continue
+		}
+		notNull := true
+		var typed *Column
+		for _, col := range cols {
+			notNull = notNull && col.NotNull
+			switch {
+			case col.DataType == "any":
+			case typed == nil:
+				typed = col
+			case typed.DataType != col.DataType || typed.IsArray != col.IsArray:
+				// The conflict is reported by the code generator
+				return
+			}
+		}
+		for _, col := range cols {
+			if col.DataType == "any" && typed != nil {
+				name := col.Name
+				*col = *typed
+				col.Name = name
+			}
+			col.NotNull = notNull
+		}
+	}
+}
+
+// inferParam returns the column which a parameter is expected to match. It
+// is found from the other operand of an operator, the declared argument of
+// the function the parameter is passed to, the other branches of a CASE,
+// the matching member of a row comparison or the target column of an
+// INSERT or UPDATE. Nil is returned when nothing is known.
+func inferParam(qc *QueryCatalog, root ast.Node, ref *ast.ParamRef) *Column {
+	v := &paramPath{target: ref}
+	astutils.Walk(v, root)
+	if v.path == nil {
+		return nil
+	}
+	inf := &paramInference{qc: qc, path: v.path}
+	col := inf.expected(len(v.path) - 1)
+	if col == nil || col.DataType == "any" {
+		return nil
+	}
+	return col
+}
+
+// paramPath finds the nodes from the root of a statement down to a node.
+type paramPath struct {
+	target ast.Node
+	stack  []ast.Node
+	path   []ast.Node
+}
+
+func (p *paramPath) Visit(node ast.Node) astutils.Visitor {
+	switch {
+	case p.path != nil:
+		return nil
+	case node == nil:
+		p.stack = p.stack[:len(p.stack)-1]
+		return nil
+	}
+	p.stack = append(p.stack, node)
+	if node == p.target {
+		p.path = append([]ast.Node{}, p.stack...)
+	}
+	return p
+}
+
+type paramInference struct {
+	qc   *QueryCatalog
+	path []ast.Node
+}
+
+// parent returns the index of the closest ancestor of the i-th node of the
+// path which is not a list, or -1.
+func (inf *paramInference) parent(i int) int {
+	for j := i - 1; j >= 0; j-- {
+		if _, ok := inf.path[j].(*ast.List); !ok {
+			return j
+		}
+	}
+	return -1
+}
+
+// column returns the column of an expression in the scope of the i-th node
+// of the path.
+func (inf *paramInference) column(i int, node ast.Node) *Column {
+	if node == nil {
+		return &Column{DataType: "any"}
+	}
+	stmt := inf.stmt(i)
+	tables, _ := sourceTables(inf.qc, stmt)
+	if ins, ok := stmt.(*ast.InsertStmt); ok && ins.OnConflictClause != nil && len(tables) > 0 {
+		tables = append(tables, excludedTable(tables[0]))
+		if aliasTable := rowAliasTable(tables[0], ins); aliasTable != nil {
+			tables = append(tables, aliasTable)
+		}
+	}
+	col, err := exprColumn(inf.qc, tables, stmt, node)
+	if err != nil {
+		return &Column{DataType: "any"}
+	}
+	return col
+}
+
+func (inf *paramInference) columns(i int, nodes []ast.Node) []*Column {
+	var cols []*Column
+	for _, node := range nodes {
+		cols = append(cols, inf.column(i, node))
+	}
+	return cols
+}
+
+// stmt returns the statement which the i-th node of the path belongs to.
+func (inf *paramInference) stmt(i int) ast.Node {
+	for ; i >= 0; i-- {
+		switch inf.path[i].(type) {
+		case *ast.SelectStmt, *ast.InsertStmt, *ast.UpdateStmt, *ast.DeleteStmt:
+			return inf.path[i]
+		}
+	}
+	return nil
+}
+
+// columnNamed returns the column of a table of a statement.
+func (inf *paramInference) columnNamed(stmt ast.Node, name string) *Column {
+	tables, err := sourceTables(inf.qc, stmt)
+	if err != nil {
+		return nil
+	}
+	ref := &ast.ColumnRef{Fields: &ast.List{Items: []ast.Node{&ast.String{Str: name}}}}
+	cols, err := outputColumnRefs(&ast.ResTarget{}, tables, ref)
+	if err != nil || len(cols) == 0 {
+		return nil
+	}
+	return cols[0]
+}
+
+// expected returns the column which the value of the i-th node of the path
+// is expected to match.
+func (inf *paramInference) expected(i int) *Column {
+	node := inf.path[i]
+	j := inf.parent(i)
+	if j < 0 {
+		return nil
+	}
+	switch p := inf.path[j].(type) {
+
+	case *ast.A_Expr:
+		return inf.operator(j, p, node)
+
+	case *ast.BoolExpr, *ast.BooleanTest:
+		return &Column{DataType: "bool", NotNull: true}
+
+	case *ast.JoinExpr:
+		if node == p.Quals {
+			return &Column{DataType: "bool", NotNull: true}
+		}
+
+	case *ast.SelectStmt:
+		switch {
+		case node == p.WhereClause, node == p.HavingClause:
+			return &Column{DataType: "bool", NotNull: true}
+		case node == p.LimitCount:
+			return &Column{Name: "limit", DataType: "integer", NotNull: true}
+		case node == p.LimitOffset:
+			return &Column{Name: "offset", DataType: "integer", NotNull: true}
+		case p.ValuesLists != nil && j+3 == i && inf.path[j+1] == p.ValuesLists:
+			return inf.values(j, p, i)
+		}
+
+	case *ast.UpdateStmt:
+		if node == p.WhereClause {
+			return &Column{DataType: "bool", NotNull: true}
+		}
+
+	case *ast.DeleteStmt:
+		if node == p.WhereClause {
+			return &Column{DataType: "bool", NotNull: true}
+		}
+
+	case *ast.ResTarget:
+		return inf.target(j, p)
+
+	case *ast.TypeCast:
+		if p.TypeName != nil {
+			return toColumn(p.TypeName)
+		}
+
+	case *ast.CollateClause:
+		return inf.expected(j)
+
+	case *ast.FuncCall:
+		return inf.funcArg(j, p, node, "")
+
+	case *ast.NamedArgExpr:
+		if k := inf.parent(j); k >= 0 && p.Name != nil {
+			if fn, ok := inf.path[k].(*ast.FuncCall); ok {
+				return inf.funcArg(k, fn, p, *p.Name)
+			}
+		}
+
+	case *ast.CaseExpr:
+		switch {
+		case node == p.Arg:
+			var exprs []ast.Node
+			for _, item := range p.Args.Items {
+				if when, ok := item.(*ast.CaseWhen); ok {
+					exprs = append(exprs, when.Expr)
+				}
+			}
+			return &Column{DataType: commonType(inf.columns(j, exprs)), NotNull: true}
+		case node == p.Defresult:
+			return inf.caseResult(j, p)
+		}
+
+	case *ast.CaseWhen:
+		k := inf.parent(j)
+		if k < 0 {
+			break
+		}
+		c, ok := inf.path[k].(*ast.CaseExpr)
+		if !ok {
+			break
+		}
+		switch node {
+		case p.Expr:
+			if _, ok := c.Arg.(*ast.TODO); c.Arg == nil || ok {
+				return &Column{DataType: "bool", NotNull: true}
+			}
+			return inf.column(k, c.Arg)
+		case p.Result:
+			return inf.caseResult(k, c)
+		}
+
+	case *ast.CoalesceExpr:
+		if col := inf.expected(j); col != nil && knownType(col) != "" {
+			return col
+		}
+		return &Column{DataType: commonType(inf.columns(j, p.Args.Items)), NotNull: true}
+
+	case *ast.MinMaxExpr:
+		if col := inf.expected(j); col != nil && knownType(col) != "" {
+			return col
+		}
+		return &Column{DataType: commonType(inf.columns(j, p.Args.Items)), NotNull: true}
+
+	case *ast.A_ArrayExpr:
+		if col := inf.expected(j); col != nil && col.IsArray {
+			return &Column{DataType: col.DataType, NotNull: true}
+		}
+		return &Column{DataType: commonType(inf.columns(j, p.Elements.Items)), NotNull: true}
+
+	case *ast.RowExpr:
+		return inf.rowMember(j, p, node)
+
+	case *ast.In:
+		if node == p.Expr {
+			return &Column{DataType: commonType(inf.columns(j, p.List)), NotNull: true}
+		}
+		return inf.column(j, p.Expr)
+
+	case *ast.BetweenExpr:
+		if node == p.Expr {
+			return &Column{DataType: commonType(inf.columns(j, []ast.Node{p.Left, p.Right})), NotNull: true}
+		}
+		return inf.column(j, p.Expr)
+
+	case *ast.SubLink:
+		if node == p.Testexpr && p.Subselect != nil {
+			cols, err := outputColumns(inf.qc, p.Subselect)
+			if err == nil && len(cols) > 0 {
+				return cols[0]
+			}
+		}
+	}
+	return nil
+}
+
+// operator returns the column expected of an operand of an operator, which
+// is usually that of the other operand.
+func (inf *paramInference) operator(j int, p *ast.A_Expr, node ast.Node) *Column {
+	other := p.Lexpr
+	if node == p.Lexpr {
+		other = p.Rexpr
+	}
+	switch p.Kind {
+	case ast.A_Expr_Kind_OP_ANY, ast.A_Expr_Kind_OP_ALL:
+		col := *inf.column(j, other)
+		col.IsArray = node != p.Lexpr
+		return &col
+	case ast.A_Expr_Kind_LIKE, ast.A_Expr_Kind_ILIKE, ast.A_Expr_Kind_SIMILAR:
+		return &Column{DataType: "text", NotNull: true}
+	case ast.A_Expr_Kind_IN:
+		if list, ok := p.Rexpr.(*ast.List); ok && node == p.Lexpr {
+			return &Column{DataType: commonType(inf.columns(j, list.Items)), NotNull: true}
+		}
+		return inf.column(j, p.Lexpr)
+	}
+	if other == nil {
+		return inf.expected(j)
+	}
+
+	op := astutils.Join(p.Name, "")
+	col := inf.column(j, other)
+	switch {
+	case lang.IsComparisonOperator(op), p.Kind != ast.A_Expr_Kind_OP:
+		return col
+	case lang.IsConcatOperator(op):
+		if col.IsArray {
+			return col
+		}
+		return &Column{DataType: "text", NotNull: true}
+	case lang.IsMathematicalOperator(op):
+		if lang.IsNumericType(col.DataType) {
+			return col
+		}
+		if knownType(col) == "" {
+			return inf.expected(j)
+		}
+	}
+	return nil
+}
+
+// caseResult returns the column expected of the result of a CASE
+// expression, from where the expression is used or from its other results.
+func (inf *paramInference) caseResult(j int, c *ast.CaseExpr) *Column {
+	if col := inf.expected(j); col != nil && knownType(col) != "" {
+		return col
+	}
+	var results []ast.Node
+	for _, item := range c.Args.Items {
+		if when, ok := item.(*ast.CaseWhen); ok {
+			results = append(results, when.Result)
+		}
+	}
+	if _, ok := c.Defresult.(*ast.TODO); c.Defresult != nil && !ok {
+		results = append(results, c.Defresult)
+	}
+	return &Column{DataType: commonType(inf.columns(j, results)), NotNull: true}
+}
+
+// rowMember returns the column expected of a member of a row constructor
+// which is compared with another row.
+func (inf *paramInference) rowMember(j int, row *ast.RowExpr, node ast.Node) *Column {
+	k := inf.parent(j)
+	if k < 0 || row.Args == nil {
+		return nil
+	}
+	if multi, ok := inf.path[k].(*ast.MultiAssignRef); ok {
+		return inf.multiAssign(k, multi, row, node)
+	}
+	expr, ok := inf.path[k].(*ast.A_Expr)
+	if !ok {
+		return nil
+	}
+	other, ok := expr.Lexpr.(*ast.RowExpr)
+	if other == row {
+		other, ok = expr.Rexpr.(*ast.RowExpr)
+	}
+	if !ok || other.Args == nil {
+		return nil
+	}
+	for idx, item := range row.Args.Items {
+		if item == node && idx < len(other.Args.Items) {
+			return inf.column(k, other.Args.Items[idx])
+		}
+	}
+	return nil
+}
+
+// multiAssign returns the column expected of a member of a row assigned to
+// several columns, as by SET (a, b) = (x, y).
+func (inf *paramInference) multiAssign(k int, multi *ast.MultiAssignRef, row *ast.RowExpr, node ast.Node) *Column {
+	l := inf.parent(k)
+	if l < 0 {
+		return nil
+	}
+	m := inf.parent(l)
+	if m < 0 {
+		return nil
+	}
+	var targets *ast.List
+	switch stmt := inf.path[m].(type) {
+	case *ast.UpdateStmt:
+		targets = stmt.TargetList
+	case *ast.OnConflictClause:
+		targets = stmt.TargetList
+	}
+	if targets == nil {
+		return nil
+	}
+	// The columns assigned from the row are consecutive targets
+	start := -1
+	for pos, item := range targets.Items {
+		if item == inf.path[l] {
+			start = pos - (multi.Colno - 1)
+		}
+	}
+	for idx, item := range row.Args.Items {
+		if item != node || start < 0 || start+idx >= len(targets.Items) {
+			continue
+		}
+		if res, ok := targets.Items[start+idx].(*ast.ResTarget); ok {
+			return inf.target(l, res)
+		}
+	}
+	return nil
+}
+
+// values returns the column expected of a value of a VALUES list, which is
+// the target column of an INSERT or else the values of the other rows.
+func (inf *paramInference) values(j int, sel *ast.SelectStmt, i int) *Column {
+	row, ok := inf.path[i-1].(*ast.List)
+	if !ok {
+		return nil
+	}
+	idx := -1
+	for n, item := range row.Items {
+		if item == inf.path[i] {
+			idx = n
+		}
+	}
+	if idx < 0 {
+		return nil
+	}
+	if k := inf.parent(j); k >= 0 {
+		if ins, ok := inf.path[k].(*ast.InsertStmt); ok {
+			return inf.insertColumn(ins, idx)
+		}
+	}
+	var others []*Column
+	for _, item := range sel.ValuesLists.Items {
+		if other, ok := item.(*ast.List); ok && other != row && idx < len(other.Items) {
+			others = append(others, inf.column(j, other.Items[idx]))
+		}
+	}
+	return &Column{DataType: commonType(others), NotNull: true}
+}
+
+// target returns the column expected of the value of a result target: the
+// column set by an UPDATE, or the column of an INSERT which a SELECT
+// provides.
+func (inf *paramInference) target(j int, res *ast.ResTarget) *Column {
+	k := inf.parent(j)
+	if k < 0 {
+		return nil
+	}
+	switch stmt := inf.path[k].(type) {
+	case *ast.UpdateStmt:
+		if res.Name != nil {
+			return inf.columnNamed(stmt, *res.Name)
+		}
+	case *ast.OnConflictClause:
+		if res.Name != nil {
+			return inf.columnNamed(inf.stmt(k), *res.Name)
+		}
+	case *ast.SelectStmt:
+		l := inf.parent(k)
+		if l < 0 {
+			break
+		}
+		ins, ok := inf.path[l].(*ast.InsertStmt)
+		if !ok || ins.SelectStmt != stmt || stmt.TargetList == nil {
+			break
+		}
+		for idx, item := range stmt.TargetList.Items {
+			if item == res {
+				return inf.insertColumn(ins, idx)
+			}
+		}
+	}
+	return nil
+}
+
+// insertColumn returns the idx-th target column of an INSERT.
+func (inf *paramInference) insertColumn(ins *ast.InsertStmt, idx int) *Column {
+	if ins.Cols == nil || idx >= len(ins.Cols.Items) {
+		return nil
+	}
+	res, ok := ins.Cols.Items[idx].(*ast.ResTarget)
+	if !ok || res.Name == nil {
+		return nil
+	}
+	return inf.columnNamed(ins, *res.Name)
+}
+
+// funcArg returns the column expected of an argument of a function call,
+// taken from the overload which matches the other arguments and the type
+// expected of the result.
+func (inf *paramInference) funcArg(j int, fn *ast.FuncCall, node ast.Node, name string) *Column {
+	fun, err := inf.qc.catalog.ResolveFuncCall(fn)
+	if err != nil || fn.Args == nil {
+		return nil
+	}
+	pos := -1
+	for idx, item := range fn.Args.Items {
+		if item == node {
+			pos = idx
+		}
+	}
+	var want string
+	if col := inf.expected(j); col != nil {
+		want = knownType(col)
+	}
+	args := inf.columns(j, fn.Args.Items)
+	fun = funcOverload(inf.qc, fn, fun, args, want)
+
+	params := fun.InArgs()
+	var param *catalog.Argument
+	switch {
+	case name != "":
+		for _, arg := range params {
+			if arg.Name == name {
+				param = arg
+			}
+		}
+	case pos >= 0 && pos < len(params):
+		param = params[pos]
+	case pos >= 0 && len(params) > 0 && params[len(params)-1].Mode == ast.FuncParamVariadic:
+		param = params[len(params)-1]
+	}
+	if param == nil || param.Type == nil {
+		return nil
+	}
+
+	typ := dataType(param.Type)
+	col := &Column{Name: param.Name, DataType: typ, NotNull: true, IsArray: isArray(param.Type)}
+	if col.Name == "" {
+		col.Name = fun.Name
+	}
+	if elem := strings.TrimSuffix(typ, "[]"); elem != typ {
+		col.DataType = elem
+		col.IsArray = param.Mode != ast.FuncParamVariadic
+	}
+	if lang.IsPolymorphicType(typ) {
+		// A polymorphic argument has the type of the other polymorphic
+		// arguments, or of the result
+		col.DataType = "any"
+		for idx, other := range params {
+			if other == param || idx >= len(args) || !lang.IsPolymorphicType(dataType(other.Type)) {
+				continue
+			}
+			if t := knownElemType(args[idx]); t != "" {
+				col.DataType = t
+				break
+			}
+		}
+		if col.DataType == "any" && want != "" && fun.ReturnType != nil && lang.IsPolymorphicType(dataType(fun.ReturnType)) {
+			col.DataType = want
+		}
+		col.IsArray = lang.IsPolymorphicArrayType(typ)
+	}
+	return col
+}
@@ -0,0 +1,159 @@
+package compiler
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/stephenwithav/sqlc/pkg/config"
+)
+
+func TestInferParameters(t *testing.T) {
+	schema := "CREATE TABLE books (id integer PRIMARY KEY, author_id bigint NOT NULL, price numeric NOT NULL, title text);"
+
+	type param struct {
+		Name     string
+		DataType string
+		IsArray  bool
+	}
+	for _, tc := range []struct {
+		engine config.Engine
+		schema string
+		query  string
+		params []param
+	}{
+		{
+			config.EnginePostgreSQL,
+			"",
+			"SELECT * FROM books WHERE title = substr($1, 1, $2) AND date_part('year', now()) > $3 LIMIT $4 OFFSET $5",
+			[]param{
+				{"substr", "text", false},
+				{"substr", "integer", false},
+				{"", "double precision", false},
+				{"limit", "integer", false},
+				{"offset", "integer", false},
+			},
+		},
+		{
+			config.EnginePostgreSQL,
+			"",
+			"SELECT CASE WHEN $1 THEN title ELSE $2 END AS t FROM books WHERE (author_id, price) > ($3, $4)",
+			[]param{
+				{"", "bool", false},
+				{"", "text", false},
+				{"author_id", "pg_catalog.int8", false},
+				{"price", "pg_catalog.numeric", false},
+			},
+		},
+		{
+			config.EnginePostgreSQL,
+			"CREATE TABLE posts (id integer PRIMARY KEY, tags text[] NOT NULL);",
+			"SELECT * FROM posts WHERE $1 = ANY(tags) AND coalesce($2, id) > 1",
+			[]param{
+				{"tags", "text", false},
+				{"", "int", false},
+			},
+		},
+		{
+			config.EnginePostgreSQL,
+			"",
+			"INSERT INTO books (id, author_id, price) VALUES ($1, $2, $3), ($4, abs($5), $6)",
+			[]param{
+				{"id", "pg_catalog.int4", false},
+				{"author_id", "pg_catalog.int8", false},
+				{"price", "pg_catalog.numeric", false},
+				{"id", "pg_catalog.int4", false},
+				{"abs", "bigint", false},
+				{"price", "pg_catalog.numeric", false},
+			},
+		},
+		{
+			config.EnginePostgreSQL,
+			"",
+			"UPDATE books SET title = CASE WHEN sqlc.narg('new_title') IS NULL THEN title ELSE sqlc.narg('new_title') END WHERE id = @id",
+			[]param{
+				{"new_title", "text", false},
+				{"id", "pg_catalog.int4", false},
+			},
+		},
+		{
+			config.EnginePostgreSQL,
+			"",
+			"UPDATE books SET (title, price) = ($2, $1) WHERE id = $3",
+			[]param{
+				{"price", "pg_catalog.numeric", false},
+				{"title", "text", false},
+				{"id", "pg_catalog.int4", false},
+			},
+		},
+		{
+			config.EngineMySQL,
+			"",
+			"SELECT CASE WHEN ? THEN title ELSE ? END AS t FROM books WHERE (author_id, price) > (?, ?) LIMIT ?",
+			[]param{
+				{"", "bool", false},
+				{"", "text", false},
+				{"author_id", "bigint", false},
+				{"price", "decimal", false},
+				{"limit", "integer", false},
+			},
+		},
+		{
+			config.EngineMySQL,
+			"",
+			"SELECT * FROM books WHERE title = CASE WHEN sqlc.arg(title) = '' THEN NULL ELSE sqlc.arg(title) END",
+			[]param{
+				{"title", "text", false},
+				{"title", "text", false},
+			},
+		},
+		{
+			config.EngineSQLite,
+			"",
+			"SELECT CASE WHEN ? THEN title ELSE ? END AS t FROM books WHERE (author_id, price) > (?, ?)",
+			[]param{
+				{"", "bool", false},
+				{"", "text", false},
+				{"author_id", "bigint", false},
+				{"price", "numeric", false},
+			},
+		},
+		{
+			config.EngineSQLite,
+			"",
+			"INSERT INTO books (id, author_id, price) VALUES (?, ?, ?), (?, ?, ?)",
+			[]param{
+				{"id", "integer", false},
+				{"author_id", "bigint", false},
+				{"price", "numeric", false},
+				{"id", "integer", false},
+				{"author_id", "bigint", false},
+				{"price", "numeric", false},
+			},
+		},
+		{
+			config.EngineSQLite,
+			"",
+			"SELECT * FROM books WHERE title IS ? AND title IS NOT ? AND price IS NOT NULL",
+			[]param{
+				{"title", "text", false},
+				{"title", "text", false},
+			},
+		},
+	} {
+		tc := tc
+		t.Run(tc.query, func(t *testing.T) {
+			query, err := compileQuery(config.SQL{Engine: tc.engine}, schema+tc.schema, tc.query)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got []param
+			for _, p := range query.Params {
+				got = append(got, param{p.Column.Name, p.Column.DataType, p.Column.IsArray})
+			}
+			if diff := cmp.Diff(tc.params, got); diff != "" {
+				t.Errorf("parameters differ (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
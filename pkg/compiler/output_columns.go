@@ -1,14 +1,12 @@
 package compiler
 
 import (
-	"errors"
 	"fmt"
 
 	"github.com/stephenwithav/sqlc/pkg/sql/catalog"
 
 	"github.com/stephenwithav/sqlc/pkg/sql/ast"
 	"github.com/stephenwithav/sqlc/pkg/sql/astutils"
-	"github.com/stephenwithav/sqlc/pkg/sql/lang"
 	"github.com/stephenwithav/sqlc/pkg/sql/sqlerr"
 )
 
@@ -32,6 +30,7 @@ func (c *Compiler) OutputColumns(stmt ast.Node) ([]*catalog.Column, error) {
 			IsArray:   col.IsArray,
 			Comment:   col.Comment,
 			Length:    col.Length,
+			Collation: col.Collation,
 		})
 	}
 	return catCols, nil
@@ -84,7 +83,7 @@ func outputColumns(qc *QueryCatalog, node ast.Node) ([]*Column, error) {
 			return outputColumns(qc, n.Larg)
 		}
 	case *ast.CallStmt:
-		targets = &ast.List{}
+		return callColumns(qc, n), nil
 	case *ast.TruncateStmt:
 		targets = &ast.List{}
 	case *ast.UpdateStmt:
@@ -102,79 +101,35 @@ func outputColumns(qc *QueryCatalog, node ast.Node) ([]*Column, error) {
 		}
 		switch n := res.Val.(type) {
 
-		case *ast.A_Expr:
-			name := ""
-			if res.Name != nil {
-				name = *res.Name
-			}
-			switch {
-			case lang.IsComparisonOperator(astutils.Join(n.Name, "")):
-				// TODO: Generate a name for these operations
-				cols = append(cols, &Column{Name: name, DataType: "bool", NotNull: true})
-			case lang.IsMathematicalOperator(astutils.Join(n.Name, "")):
-				cols = append(cols, &Column{Name: name, DataType: "int", NotNull: true})
-			default:
-				cols = append(cols, &Column{Name: name, DataType: "any", NotNull: false})
-			}
-
 		case *ast.CaseExpr:
 			name := ""
-			if res.Name != nil {
-				name = *res.Name
-			}
-			// TODO: The TypeCase code has been copied from below. Instead, we
-			// need a recurse function to get the type of a node.
 			if tc, ok := n.Defresult.(*ast.TypeCast); ok {
-				if tc.TypeName == nil {
-					return nil, errors.New("no type name type cast")
-				}
-				name := ""
 				if ref, ok := tc.Arg.(*ast.ColumnRef); ok {
 					name = astutils.Join(ref.Fields, "_")
 				}
-				if res.Name != nil {
-					name = *res.Name
-				}
-				// TODO Validate column names
-				col := toColumn(tc.TypeName)
-				col.Name = name
-				cols = append(cols, col)
-			} else {
-				cols = append(cols, &Column{Name: name, DataType: "any", NotNull: false})
 			}
-
-		case *ast.CoalesceExpr:
-			name := "coalesce"
 			if res.Name != nil {
 				name = *res.Name
 			}
-			var firstColumn *Column
-			var shouldNotBeNull bool
-			for _, arg := range n.Args.Items {
-				if _, ok := arg.(*ast.A_Const); ok {
-					shouldNotBeNull = true
-					continue
-				}
-				if ref, ok := arg.(*ast.ColumnRef); ok {
-					columns, err := outputColumnRefs(res, tables, ref)
-					if err != nil {
-						return nil, err
-					}
-					for _, c := range columns {
-						if firstColumn == nil {
-							firstColumn = c
-						}
-						shouldNotBeNull = shouldNotBeNull || c.NotNull
-					}
-				}
+			col, err := exprColumn(qc, tables, node, n)
+			if err != nil {
+				return nil, err
 			}
-			if firstColumn != nil {
-				firstColumn.NotNull = shouldNotBeNull
-				firstColumn.skipTableRequiredCheck = true
-				cols = append(cols, firstColumn)
-			} else {
-				cols = append(cols, &Column{Name: name, DataType: "any", NotNull: false})
+			col.Name = name
+			cols = append(cols, col)
+
+		case *ast.CoalesceExpr:
+			col, err := exprColumn(qc, tables, node, n)
+			if err != nil {
+				return nil, err
+			}
+			if col.Table == nil {
+				col.Name = "coalesce"
+			}
+			if res.Name != nil {
+				col.Name = *res.Name
 			}
+			cols = append(cols, col)
 
 		case *ast.ColumnRef:
 			if hasStarRef(n) {
@@ -185,6 +140,9 @@ func outputColumns(qc *QueryCatalog, node ast.Node) ([]*Column, error) {
 						continue
 					}
 					for _, c := range t.Columns {
+						if c.hidden {
+							continue
+						}
 						cname := c.Name
 						if res.Name != nil {
 							cname = *res.Name
@@ -199,6 +157,7 @@ func outputColumns(qc *QueryCatalog, node ast.Node) ([]*Column, error) {
 							NotNull:    c.NotNull,
 							IsArray:    c.IsArray,
 							Length:     c.Length,
+							Collation:  c.Collation,
 						})
 					}
 				}
@@ -217,21 +176,12 @@ func outputColumns(qc *QueryCatalog, node ast.Node) ([]*Column, error) {
 			if res.Name != nil {
 				name = *res.Name
 			}
-			fun, err := qc.catalog.ResolveFuncCall(n)
-			if err == nil {
-				cols = append(cols, &Column{
-					Name:       name,
-					DataType:   dataType(fun.ReturnType),
-					NotNull:    !fun.ReturnTypeNullable,
-					IsFuncCall: true,
-				})
-			} else {
-				cols = append(cols, &Column{
-					Name:       name,
-					DataType:   "any",
-					IsFuncCall: true,
-				})
+			col, err := funcColumn(qc, tables, node, n)
+			if err != nil {
+				return nil, err
 			}
+			col.Name = name
+			cols = append(cols, col)
 
 		case *ast.SubLink:
 			name := "exists"
@@ -256,9 +206,6 @@ func outputColumns(qc *QueryCatalog, node ast.Node) ([]*Column, error) {
 			}
 
 		case *ast.TypeCast:
-			if n.TypeName == nil {
-				return nil, errors.New("no type name type cast")
-			}
 			name := ""
 			if ref, ok := n.Arg.(*ast.ColumnRef); ok {
 				name = astutils.Join(ref.Fields, "_")
@@ -267,14 +214,11 @@ func outputColumns(qc *QueryCatalog, node ast.Node) ([]*Column, error) {
 				name = *res.Name
 			}
 			// TODO Validate column names
-			col := toColumn(n.TypeName)
-			col.Name = name
-			// TODO Add correct, real type inference
-			if constant, ok := n.Arg.(*ast.A_Const); ok {
-				if _, ok := constant.Val.(*ast.Null); ok {
-					col.NotNull = false
-				}
+			col, err := exprColumn(qc, tables, node, n)
+			if err != nil {
+				return nil, err
 			}
+			col.Name = name
 			cols = append(cols, col)
 
 		case *ast.SelectStmt:
@@ -293,7 +237,12 @@ func outputColumns(qc *QueryCatalog, node ast.Node) ([]*Column, error) {
 			if res.Name != nil {
 				name = *res.Name
 			}
-			cols = append(cols, &Column{Name: name, DataType: "any", NotNull: false})
+			col, err := exprColumn(qc, tables, node, n)
+			if err != nil {
+				return nil, err
+			}
+			col.Name = name
+			cols = append(cols, col)
 
 		}
 	}
@@ -303,16 +252,72 @@ func outputColumns(qc *QueryCatalog, node ast.Node) ([]*Column, error) {
 			if !col.NotNull || col.Table == nil || col.skipTableRequiredCheck {
 				continue
 			}
-			for _, f := range n.FromClause.Items {
-				if res := isTableRequired(f, col, tableRequired); res != tableNotFound {
-					col.NotNull = res == tableRequired
-					break
+			col.NotNull = !isOuterJoined(n, col)
+		}
+	}
+
+	return cols, nil
+}
+
+// callColumns returns the OUT and INOUT arguments of a procedure, which
+// PostgreSQL returns as a row and MySQL assigns to the user variables passed
+// in their place.
+func callColumns(qc *QueryCatalog, n *ast.CallStmt) []*Column {
+	call := n.FuncCall
+	if call == nil || call.Args == nil {
+		return nil
+	}
+	var args []*catalog.Argument
+	if fun, err := qc.catalog.ResolveCall(call); err == nil {
+		args = fun.CallArgs()
+	}
+	var cols []*Column
+	for i, item := range call.Args.Items {
+		var arg *catalog.Argument
+		if named, ok := item.(*ast.NamedArgExpr); ok {
+			item = named.Arg
+			for _, a := range args {
+				if named.Name != nil && a.Name == *named.Name {
+					arg = a
 				}
 			}
+		} else if i < len(args) {
+			arg = args[i]
+		}
+
+		col := &Column{DataType: "any"}
+		if arg != nil {
+			col.Name = arg.Name
+			col.DataType = dataType(arg.Type)
+			col.IsArray = arg.Type.ArrayBounds != nil
+			col.Type = arg.Type
+		}
+		if v, ok := item.(*ast.VariableExpr); ok && !v.IsSystem {
+			col.Name = v.Name
+		} else if arg == nil || (arg.Mode != ast.FuncParamOut && arg.Mode != ast.FuncParamInOut) {
+			continue
+		}
+		if col.Name == "" {
+			col.Name = call.Func.Name
 		}
+		cols = append(cols, col)
 	}
+	return cols
+}
 
-	return cols, nil
+// isOuterJoined reports whether the table of a column may be missing from
+// the rows of a statement because of an outer join.
+func isOuterJoined(node ast.Node, col *Column) bool {
+	n, ok := node.(*ast.SelectStmt)
+	if !ok || col.Table == nil || n.FromClause == nil {
+		return false
+	}
+	for _, f := range n.FromClause.Items {
+		if res := isTableRequired(f, col, tableRequired); res != tableNotFound {
+			return res != tableRequired
+		}
+	}
+	return false
 }
 
 const (
@@ -379,7 +384,7 @@ func sourceTables(qc *QueryCatalog, node ast.Node) ([]*Table, error) {
 	case *ast.SelectStmt:
 		list = astutils.Search(n.FromClause, func(node ast.Node) bool {
 			switch node.(type) {
-			case *ast.RangeVar, *ast.RangeSubselect, *ast.FuncName:
+			case *ast.RangeVar, *ast.RangeSubselect, *ast.RangeFunction:
 				return true
 			default:
 				return false
@@ -404,18 +409,10 @@ func sourceTables(qc *QueryCatalog, node ast.Node) ([]*Table, error) {
 	for _, item := range list.Items {
 		switch n := item.(type) {
 
-		case *ast.FuncName:
-			// If the function or table can't be found, don't error out.  There
-			// are many queries that depend on functions unknown to sqlc.
-			fn, err := qc.GetFunc(n)
-			if err != nil {
-				continue
-			}
-			table, err := qc.GetTable(&ast.TableName{
-				Catalog: fn.ReturnType.Catalog,
-				Schema:  fn.ReturnType.Schema,
-				Name:    fn.ReturnType.Name,
-			})
+		case *ast.RangeFunction:
+			// If the function can't be found, don't error out.  There are
+			// many queries that depend on functions unknown to sqlc.
+			table, err := qc.GetFuncTable(n)
 			if err != nil {
 				continue
 			}
@@ -446,10 +443,14 @@ func sourceTables(qc *QueryCatalog, node ast.Node) ([]*Table, error) {
 				return nil, cerr
 			}
 			if n.Alias != nil {
-				table.Rel = &ast.TableName{
-					Catalog: table.Rel.Catalog,
-					Schema:  table.Rel.Schema,
-					Name:    *n.Alias.Aliasname,
+				// The tables of common table expressions are shared
+				table = &Table{
+					Rel: &ast.TableName{
+						Catalog: table.Rel.Catalog,
+						Schema:  table.Rel.Schema,
+						Name:    *n.Alias.Aliasname,
+					},
+					Columns: table.Columns,
 				}
 			}
 			tables = append(tables, table)
@@ -463,13 +464,17 @@ func sourceTables(qc *QueryCatalog, node ast.Node) ([]*Table, error) {
 
 func outputColumnRefs(res *ast.ResTarget, tables []*Table, node *ast.ColumnRef) ([]*Column, error) {
 	parts := stringSlice(node.Fields)
-	var name, alias string
+	var name, alias, schema string
 	switch {
 	case len(parts) == 1:
 		name = parts[0]
 	case len(parts) == 2:
 		alias = parts[0]
 		name = parts[1]
+	case len(parts) == 3:
+		schema = parts[0]
+		alias = parts[1]
+		name = parts[2]
 	default:
 		return nil, fmt.Errorf("unknown number of fields: %d", len(parts))
 	}
@@ -479,6 +484,12 @@ func outputColumnRefs(res *ast.ResTarget, tables []*Table, node *ast.ColumnRef)
 		if alias != "" && t.Rel.Name != alias {
 			continue
 		}
+		if schema != "" && t.schema != schema {
+			continue
+		}
+		if alias == "" && t.qualified {
+			continue
+		}
 		for _, c := range t.Columns {
 			if c.Name == name {
 				found += 1
@@ -495,6 +506,7 @@ func outputColumnRefs(res *ast.ResTarget, tables []*Table, node *ast.ColumnRef)
 					NotNull:    c.NotNull,
 					IsArray:    c.IsArray,
 					Length:     c.Length,
+					Collation:  c.Collation,
 				})
 			}
 		}
@@ -518,12 +530,16 @@ func outputColumnRefs(res *ast.ResTarget, tables []*Table, node *ast.ColumnRef)
 
 func findColumnForRef(ref *ast.ColumnRef, tables []*Table, selectStatement *ast.SelectStmt) error {
 	parts := stringSlice(ref.Fields)
-	var alias, name string
+	var alias, name, schema string
 	if len(parts) == 1 {
 		name = parts[0]
 	} else if len(parts) == 2 {
 		alias = parts[0]
 		name = parts[1]
+	} else if len(parts) == 3 {
+		schema = parts[0]
+		alias = parts[1]
+		name = parts[2]
 	}
 
 	var found int
@@ -531,6 +547,9 @@ func findColumnForRef(ref *ast.ColumnRef, tables []*Table, selectStatement *ast.
 		if alias != "" && t.Rel.Name != alias {
 			continue
 		}
+		if schema != "" && t.schema != schema {
+			continue
+		}
 
 		// Find matching column
 		var foundColumn bool
@@ -0,0 +1,384 @@
+package compiler
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/stephenwithav/sqlc/pkg/config"
+	"github.com/stephenwithav/sqlc/pkg/sql/lang"
+)
+
+// sameType compares the data types of columns by the type they name, so
+// expectations spell every type the same way.
+var sameType = cmp.FilterPath(func(p cmp.Path) bool {
+	return p.Last().String() == ".DataType"
+}, cmp.Comparer(lang.SameType))
+
+func TestOutputColumnTypes(t *testing.T) {
+	schema := `
+CREATE TABLE authors (
+  id bigserial PRIMARY KEY,
+  name text NOT NULL,
+  bio text,
+  age smallint NOT NULL,
+  score real,
+  born date NOT NULL,
+  seen timestamp
+);
+CREATE TABLE books (
+  id integer PRIMARY KEY,
+  author_id bigint NOT NULL,
+  price numeric NOT NULL
+);`
+
+	type column struct {
+		Name     string
+		DataType string
+		NotNull  bool
+		IsArray  bool
+	}
+	for _, tc := range []struct {
+		query string
+		cols  []column
+	}{
+		{
+			"SELECT authors.id + 1, age * 2, age + score, price / 2 FROM authors, books",
+			[]column{
+				{"", "bigint", true, false},
+				{"", "integer", true, false},
+				{"", "real", false, false},
+				{"", "numeric", true, false},
+			},
+		},
+		{
+			"SELECT name || bio AS full, name || '!' AS loud FROM authors",
+			[]column{
+				{"full", "text", false, false},
+				{"loud", "text", true, false},
+			},
+		},
+		{
+			"SELECT id = $1 AND bio IS NOT NULL AS a, bio = $2 AS b, bio IS NULL AS c, NOT (age > 1) AS d FROM authors",
+			[]column{
+				{"a", "boolean", true, false},
+				{"b", "boolean", false, false},
+				{"c", "boolean", true, false},
+				{"d", "boolean", true, false},
+			},
+		},
+		{
+			"SELECT greatest(age, id) AS g, least(score, 1) AS l, ARRAY[age, 2] AS arr FROM authors",
+			[]column{
+				{"g", "bigint", true, false},
+				{"l", "real", true, false},
+				{"arr", "integer", true, true},
+			},
+		},
+		{
+			"SELECT CASE WHEN age > 1 THEN age ELSE id END AS c1, CASE WHEN age > 1 THEN name END AS c2 FROM authors",
+			[]column{
+				{"c1", "bigint", true, false},
+				{"c2", "text", false, false},
+			},
+		},
+		{
+			"SELECT born + 7 AS next, born - born AS days, seen - seen AS gap, id = ANY($1::bigint[]) AS found, id IN (1, 2) AS listed FROM authors",
+			[]column{
+				{"next", "date", true, false},
+				{"days", "integer", true, false},
+				{"gap", "interval", false, false},
+				{"found", "boolean", true, false},
+				{"listed", "boolean", true, false},
+			},
+		},
+		{
+			"SELECT $1::inet + 1 AS next, $1::inet - $2::inet AS distance FROM authors",
+			[]column{
+				{"next", "inet", true, false},
+				{"distance", "bigint", true, false},
+			},
+		},
+		{
+			"SELECT ($1::inet) + i AS next, $1::inet - 1::smallint AS prev FROM generate_series(0, $2::int) AS i",
+			[]column{
+				{"next", "inet", true, false},
+				{"prev", "inet", true, false},
+			},
+		},
+		{
+			"SELECT b.price + 1 AS price, b.author_id IS NULL AS orphan FROM authors a LEFT JOIN books b ON b.author_id = a.id",
+			[]column{
+				{"price", "numeric", false, false},
+				{"orphan", "boolean", true, false},
+			},
+		},
+		{
+			"SELECT 1, born + interval '1 day' AS later, coalesce(b.id, b.author_id) AS c FROM authors, books b",
+			[]column{
+				{"", "integer", true, false},
+				{"later", "timestamp without time zone", true, false},
+				{"c", "bigint", true, false},
+			},
+		},
+		{
+			"SELECT table_name::text, table_schema FROM information_schema.tables",
+			[]column{
+				{"table_name", "text", false, false},
+				{"table_schema", "sql_identifier", false, false},
+			},
+		},
+		{
+			"SELECT coalesce(bio, 'none'), coalesce(NULL, score, $1) AS s, bio::varchar AS bio_text, 1 AS one FROM authors",
+			[]column{
+				{"bio", "text", true, false},
+				{"s", "real", true, false},
+				{"bio_text", "character varying", false, false},
+				{"one", "integer", true, false},
+			},
+		},
+	} {
+		tc := tc
+		t.Run(tc.query, func(t *testing.T) {
+			query, err := compileQuery(config.SQL{Engine: config.EnginePostgreSQL}, schema, tc.query)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got []column
+			for _, col := range query.Columns {
+				got = append(got, column{col.Name, col.DataType, col.NotNull, col.IsArray})
+			}
+			if diff := cmp.Diff(tc.cols, got, sameType); diff != "" {
+				t.Errorf("columns differ (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestWindowFunctions(t *testing.T) {
+	schema := "CREATE TABLE books (id integer PRIMARY KEY, author_id bigint NOT NULL, price numeric NOT NULL, title text);"
+	testQueries(t, schema, []queryTest{
+		{
+			engine: config.EnginePostgreSQL,
+			query:  "SELECT row_number() OVER (PARTITION BY author_id ORDER BY price) AS rn, sum(price) OVER w AS total, lag(title) OVER w AS prev FROM books WINDOW w AS (PARTITION BY author_id ORDER BY id)",
+			cols: []column{
+				{"rn", "bigint", true},
+				{"total", "numeric", true},
+				{"prev", "text", false},
+			},
+		},
+		{
+			engine: config.EnginePostgreSQL,
+			query:  "SELECT count(*) FILTER (WHERE price > $1) AS expensive, max(price) FILTER (WHERE title = $2) AS top, sum(price) OVER (ORDER BY id ROWS BETWEEN $3 PRECEDING AND CURRENT ROW) AS recent FROM books",
+			cols: []column{
+				{"expensive", "bigint", true},
+				{"top", "numeric", false},
+				{"recent", "numeric", true},
+			},
+			params: []column{
+				{"price", "pg_catalog.numeric", true},
+				{"title", "text", false},
+				{"start_offset", "bigint", true},
+			},
+		},
+		{
+			engine: config.EngineMySQL,
+			query:  "SELECT row_number() OVER (PARTITION BY author_id ORDER BY price) AS rn, rank() OVER w AS r, first_value(price) OVER w AS cheapest FROM books WHERE price > ? WINDOW w AS (ORDER BY id ROWS ? PRECEDING)",
+			cols: []column{
+				{"rn", "int", true},
+				{"r", "int", true},
+				{"cheapest", "decimal", false},
+			},
+			params: []column{
+				{"price", "decimal", true},
+				{"start_offset", "bigint", true},
+			},
+		},
+		{
+			engine: config.EngineSQLite,
+			query:  "SELECT row_number() OVER w AS rn, lead(title, 1) OVER w AS next, count(*) FILTER (WHERE price > ?) AS expensive, sum(price) OVER (ORDER BY id ROWS BETWEEN ? PRECEDING AND ? FOLLOWING) AS nearby FROM books WINDOW w AS (PARTITION BY author_id ORDER BY id)",
+			cols: []column{
+				{"rn", "integer", true},
+				{"next", "text", false},
+				{"expensive", "integer", true},
+				{"nearby", "real", false},
+			},
+			params: []column{
+				{"price", "numeric", true},
+				{"start_offset", "bigint", true},
+				{"end_offset", "bigint", true},
+			},
+		},
+	})
+}
+
+func TestRecursiveCTE(t *testing.T) {
+	schema := "CREATE TABLE categories (id integer PRIMARY KEY, parent_id integer, name text NOT NULL);"
+	tree := `WITH RECURSIVE tree (id, parent, label, depth) AS (
+  SELECT id, parent_id, name, 1 FROM categories WHERE parent_id IS NULL
+  UNION ALL
+  SELECT c.id, c.parent_id, c.name, t.depth + 1 FROM categories c JOIN tree t ON c.parent_id = t.id
+)
+SELECT id, parent, label, depth FROM tree WHERE depth < $1`
+	ancestors := `WITH RECURSIVE ancestors AS (
+  SELECT id, parent_id, name FROM categories WHERE id = $1
+  UNION ALL
+  SELECT c.id, c.parent_id, c.name FROM categories c, ancestors a WHERE c.id = a.parent_id
+)
+SELECT * FROM ancestors`
+	union := "WITH names AS (SELECT name FROM categories UNION ALL SELECT NULL) SELECT name FROM names"
+
+	testQueries(t, schema, []queryTest{
+		{
+			engine: config.EnginePostgreSQL,
+			query:  tree,
+			cols:   []column{{"id", "pg_catalog.int4", true}, {"parent", "pg_catalog.int4", false}, {"label", "text", true}, {"depth", "int", true}},
+			params: []column{{"depth", "int", true}},
+		},
+		{
+			engine: config.EnginePostgreSQL,
+			query:  ancestors,
+			cols:   []column{{"id", "pg_catalog.int4", true}, {"parent_id", "pg_catalog.int4", false}, {"name", "text", true}},
+			params: []column{{"id", "pg_catalog.int4", true}},
+		},
+		{
+			engine: config.EnginePostgreSQL,
+			query:  union,
+			cols:   []column{{"name", "text", false}},
+		},
+		{
+			engine: config.EngineMySQL,
+			query:  strings.ReplaceAll(tree, "$1", "?"),
+			cols:   []column{{"id", "int", true}, {"parent", "int", false}, {"label", "text", true}, {"depth", "int", true}},
+			params: []column{{"depth", "int", true}},
+		},
+		{
+			engine: config.EngineMySQL,
+			query:  strings.ReplaceAll(ancestors, "$1", "?"),
+			cols:   []column{{"id", "int", true}, {"parent_id", "int", false}, {"name", "text", true}},
+			params: []column{{"id", "int", true}},
+		},
+		{
+			engine: config.EngineSQLite,
+			query:  strings.ReplaceAll(tree, "$1", "?"),
+			cols:   []column{{"id", "integer", true}, {"parent", "integer", false}, {"label", "text", true}, {"depth", "int", true}},
+			params: []column{{"depth", "int", true}},
+		},
+		{
+			engine: config.EngineSQLite,
+			query:  union,
+			cols:   []column{{"name", "text", false}},
+		},
+	})
+}
+
+func TestFunctionSources(t *testing.T) {
+	schema := `
+CREATE TABLE users (id integer PRIMARY KEY, name text NOT NULL, bio text);
+CREATE FUNCTION search_users(q text) RETURNS SETOF users AS $$ SELECT * FROM users WHERE name LIKE q $$ LANGUAGE sql;
+CREATE FUNCTION user_stats(min_id integer) RETURNS TABLE (user_id integer, total bigint) AS $$ SELECT id, 1::bigint FROM users WHERE id >= min_id $$ LANGUAGE sql;
+CREATE FUNCTION bounds(OUT low integer, OUT high integer) AS $$ SELECT 1, 2 $$ LANGUAGE sql;
+CREATE FUNCTION user_count() RETURNS bigint AS $$ SELECT count(*) FROM users $$ LANGUAGE sql;`
+
+	testQueries(t, schema, []queryTest{
+		{
+			query:  "SELECT * FROM search_users($1)",
+			cols:   []column{{"id", "pg_catalog.int4", true}, {"name", "text", true}, {"bio", "text", false}},
+			params: []column{{"q", "text", true}},
+		},
+		{
+			query:  "SELECT s.user_id, s.total, u.name FROM user_stats($1) s JOIN users u ON u.id = s.user_id WHERE s.total > $2",
+			cols:   []column{{"user_id", "pg_catalog.int4", false}, {"total", "pg_catalog.int8", false}, {"name", "text", true}},
+			params: []column{{"min_id", "pg_catalog.int4", true}, {"total", "pg_catalog.int8", false}},
+		},
+		{
+			query: "SELECT * FROM bounds()",
+			cols:  []column{{"low", "pg_catalog.int4", false}, {"high", "pg_catalog.int4", false}},
+		},
+		{
+			query: "SELECT * FROM user_count()",
+			cols:  []column{{"user_count", "pg_catalog.int8", true}},
+		},
+		{
+			query: "SELECT b.* FROM bounds() AS b (lo, hi)",
+			cols:  []column{{"lo", "pg_catalog.int4", false}, {"hi", "pg_catalog.int4", false}},
+		},
+		{
+			query: "SELECT * FROM search_users('a') WITH ORDINALITY AS r (id, name, bio, n)",
+			cols:  []column{{"id", "pg_catalog.int4", true}, {"name", "text", true}, {"bio", "text", false}, {"n", "bigint", true}},
+		},
+	})
+}
+
+func TestMySQLExpressions(t *testing.T) {
+	schema := "CREATE TABLE posts (id int PRIMARY KEY, title text NOT NULL, body text, published date NOT NULL, featured bool, price decimal(10,2) NOT NULL, FULLTEXT (title, body));"
+	testQueries(t, schema, []queryTest{
+		{
+			engine: config.EngineMySQL,
+			query:  "SELECT body IS NULL AS a, body IS NOT NULL AS b, featured IS TRUE AS c, featured IS NOT FALSE AS d FROM posts WHERE body IS NULL AND id = ?",
+			cols:   []column{{"a", "bool", true}, {"b", "bool", true}, {"c", "bool", true}, {"d", "bool", true}},
+			params: []column{{"id", "int", true}},
+		},
+		{
+			engine: config.EngineMySQL,
+			query:  "SELECT CAST(price AS SIGNED) AS s, CAST(? AS DECIMAL(10,2)) AS amount, CONVERT(id, CHAR) AS c, CAST(published AS DATETIME) AS dt FROM posts",
+			cols:   []column{{"s", "bigint", true}, {"amount", "decimal", true}, {"c", "varchar", true}, {"dt", "datetime", true}},
+			params: []column{{"", "decimal", true}},
+		},
+		{
+			engine: config.EngineMySQL,
+			query:  "SELECT TRIM(BOTH ? FROM title) AS trimmed, DATE_ADD(published, INTERVAL ? DAY) AS later, TIMESTAMPDIFF(DAY, published, ?) AS age FROM posts",
+			cols:   []column{{"trimmed", "text", true}, {"later", "date", true}, {"age", "int", true}},
+			params: []column{{"TRIM", "text", true}, {"DATE_ADD", "any", true}, {"TIMESTAMPDIFF", "datetime", true}},
+		},
+		{
+			engine: config.EngineMySQL,
+			query:  "SELECT MATCH (title, body) AGAINST (? IN BOOLEAN MODE) AS score, title REGEXP 'x' AS matches FROM posts WHERE title REGEXP ? AND body NOT RLIKE ?",
+			cols:   []column{{"score", "double", true}, {"matches", "bool", true}},
+			params: []column{{"search", "text", true}, {"title", "text", true}, {"body", "text", false}},
+		},
+		{
+			engine: config.EngineMySQL,
+			query:  "SELECT id, title FROM posts WHERE NOT (id = ?) AND -price < ? ORDER BY 2, 1",
+			cols:   []column{{"id", "int", true}, {"title", "text", true}},
+			params: []column{{"id", "int", true}, {"price", "decimal", true}},
+		},
+	})
+}
+
+func TestColumnCollation(t *testing.T) {
+	for _, tc := range []struct {
+		engine    config.Engine
+		schema    string
+		query     string
+		collation string
+	}{
+		{
+			config.EnginePostgreSQL,
+			`CREATE TABLE users (id integer PRIMARY KEY, email text COLLATE "C" NOT NULL);`,
+			"SELECT u.email FROM users u WHERE email = $1",
+			"C",
+		},
+		{
+			config.EngineMySQL,
+			"CREATE TABLE users (id int PRIMARY KEY, email varchar(255) NOT NULL) COLLATE utf8mb4_bin;",
+			"SELECT u.email FROM users u WHERE email = ?",
+			"utf8mb4_bin",
+		},
+	} {
+		tc := tc
+		t.Run(tc.query, func(t *testing.T) {
+			query, err := compileQuery(config.SQL{Engine: tc.engine}, tc.schema, tc.query)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := query.Columns[0].Collation; got != tc.collation {
+				t.Errorf("column collation %q; want %q", got, tc.collation)
+			}
+			if got := query.Params[0].Column.Collation; got != tc.collation {
+				t.Errorf("parameter collation %q; want %q", got, tc.collation)
+			}
+		})
+	}
+}
@@ -68,7 +68,7 @@ func (c *Compiler) parseQuery(stmt ast.Node, src string, o opts.Parser) (*Query,
 	if err != nil {
 		return nil, err
 	}
-	raw, namedParams, edits := rewrite.NamedParameters(c.conf.Engine, raw, numbers, dollar)
+	raw, namedParams, edits := rewrite.NamedParameters(c.conf.Engine, raw, rawSQL, numbers, dollar)
 	if err := validate.Cmd(raw.Stmt, name, cmd); err != nil {
 		return nil, err
 	}
@@ -83,15 +83,19 @@ func (c *Compiler) parseQuery(stmt ast.Node, src string, o opts.Parser) (*Query,
 	} else {
 		sort.Slice(refs, func(i, j int) bool { return refs[i].ref.Number < refs[j].ref.Number })
 	}
+	if err := checkGenerated(c.catalog, raw.Stmt); err != nil {
+		return nil, err
+	}
 	qc, err := buildQueryCatalog(c.catalog, raw.Stmt)
 	if err != nil {
 		return nil, err
 	}
 
-	params, err := c.resolveCatalogRefs(qc, rvs, refs, namedParams)
+	params, err := c.resolveCatalogRefs(qc, raw.Stmt, rvs, refs, namedParams)
 	if err != nil {
 		return nil, err
 	}
+	unifyNamedParams(params)
 	cols, err := outputColumns(qc, raw.Stmt)
 	if err != nil {
 		return nil, err
@@ -12,6 +12,14 @@ type Function struct {
 type Table struct {
 	Rel     *ast.TableName
 	Columns []*Column
+
+	// qualified tables are only searched by column references which name
+	// them
+	qualified bool
+
+	// schema is the schema of a catalog table, which column references
+	// may name along with the table
+	schema string
 }
 
 type Column struct {
@@ -21,6 +29,7 @@ type Column struct {
 	IsArray      bool
 	Comment      string
 	Length       *int
+	Collation    string
 	IsNamedParam bool
 	IsFuncCall   bool
 
@@ -31,6 +40,8 @@ type Column struct {
 	Type       *ast.TypeName
 
 	skipTableRequiredCheck bool
+	// hidden columns are not selected by *
+	hidden bool
 }
 
 type Query struct {
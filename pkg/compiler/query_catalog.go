@@ -30,33 +30,108 @@ func buildQueryCatalog(c *catalog.Catalog, node ast.Node) (*QueryCatalog, error)
 	if with != nil {
 		for _, item := range with.Ctes.Items {
 			if cte, ok := item.(*ast.CommonTableExpr); ok {
-				cols, err := outputColumns(qc, cte.Ctequery)
+				table, err := qc.buildCTE(cte, with.Recursive)
 				if err != nil {
 					return nil, err
 				}
-				rel := &ast.TableName{Name: *cte.Ctename}
-				for i := range cols {
-					cols[i].Table = rel
-				}
-				qc.ctes[*cte.Ctename] = &Table{
-					Rel:     rel,
-					Columns: cols,
-				}
+				qc.ctes[*cte.Ctename] = table
 			}
 		}
 	}
 	return qc, nil
 }
 
+// buildCTE returns the table of a common table expression. The columns of a
+// set operation are those of its first query, which is the non-recursive
+// term of a recursive query, and are null when they are null in any of the
+// queries. The recursive term may refer to the table.
+func (qc *QueryCatalog) buildCTE(cte *ast.CommonTableExpr, recursive bool) (*Table, error) {
+	branches := setOperands(cte.Ctequery)
+	cols, err := outputColumns(qc, branches[0])
+	if err != nil {
+		return nil, err
+	}
+	// PostgreSQL keeps the column list of the query as its alias
+	names := cte.Aliascolnames
+	if names == nil || len(names.Items) == 0 {
+		names = cte.Ctecolnames
+	}
+	rel := &ast.TableName{Name: *cte.Ctename}
+	table := &Table{Rel: rel}
+	for i, c := range cols {
+		col := *c
+		col.Table = rel
+		col.TableAlias = ""
+		if names != nil && i < len(names.Items) {
+			if name, ok := names.Items[i].(*ast.String); ok {
+				col.Name = name.Str
+			}
+		}
+		table.Columns = append(table.Columns, &col)
+	}
+	if recursive {
+		qc.ctes[*cte.Ctename] = table
+	}
+
+	for _, branch := range branches[1:] {
+		more, err := outputColumns(qc, branch)
+		if err != nil {
+			return nil, err
+		}
+		for i, col := range table.Columns {
+			if i >= len(more) {
+				break
+			}
+			if !more[i].NotNull {
+				col.NotNull = false
+			}
+			if col.DataType == "any" {
+				col.DataType = more[i].DataType
+				col.IsArray = more[i].IsArray
+			}
+		}
+	}
+	return table, nil
+}
+
+// setOperands returns the queries combined by UNION, INTERSECT and EXCEPT
+// operations, from left to right.
+func setOperands(node ast.Node) []ast.Node {
+	stmt, ok := node.(*ast.SelectStmt)
+	if !ok || stmt.Larg == nil || stmt.Rarg == nil {
+		return []ast.Node{node}
+	}
+	return append(setOperands(stmt.Larg), setOperands(stmt.Rarg)...)
+}
+
+// catalogTable returns a table of the query catalog as a table of the
+// catalog.
+func catalogTable(t *Table) catalog.Table {
+	table := catalog.Table{Rel: t.Rel}
+	for _, col := range t.Columns {
+		table.Columns = append(table.Columns, &catalog.Column{
+			Name:      col.Name,
+			Type:      ast.TypeName{Name: col.DataType},
+			IsNotNull: col.NotNull,
+			IsArray:   col.IsArray,
+			Length:    col.Length,
+			Collation: col.Collation,
+		})
+	}
+	return table
+}
+
 func ConvertColumn(rel *ast.TableName, c *catalog.Column) *Column {
 	return &Column{
-		Table:    rel,
-		Name:     c.Name,
-		DataType: dataType(&c.Type),
-		NotNull:  c.IsNotNull,
-		IsArray:  c.IsArray,
-		Type:     &c.Type,
-		Length:   c.Length,
+		Table:     rel,
+		Name:      c.Name,
+		DataType:  dataType(&c.Type),
+		NotNull:   c.IsNotNull,
+		IsArray:   c.IsArray,
+		Type:      &c.Type,
+		Length:    c.Length,
+		Collation: c.Collation,
+		hidden:    c.IsHidden,
 	}
 }
 
@@ -73,7 +148,11 @@ func (qc QueryCatalog) GetTable(rel *ast.TableName) (*Table, error) {
 	for _, c := range src.Columns {
 		cols = append(cols, ConvertColumn(rel, c))
 	}
-	return &Table{Rel: rel, Columns: cols}, nil
+	schema := rel.Schema
+	if schema == "" {
+		schema = qc.catalog.DefaultSchema
+	}
+	return &Table{Rel: rel, Columns: cols, schema: schema}, nil
 }
 
 func (qc QueryCatalog) GetFunc(rel *ast.FuncName) (*Function, error) {
@@ -89,3 +168,114 @@ func (qc QueryCatalog) GetFunc(rel *ast.FuncName) (*Function, error) {
 		ReturnType: funcs[0].ReturnType,
 	}, nil
 }
+
+// rangeFunctionCall returns the function called by a function in a FROM
+// clause, or nil for ROWS FROM and other forms which aren't supported.
+func rangeFunctionCall(n *ast.RangeFunction) *ast.FuncCall {
+	if n.IsRowsfrom || n.Functions == nil || len(n.Functions.Items) != 1 {
+		return nil
+	}
+	fn := n.Functions.Items[0]
+	// Each function is a list of the call and its column definitions
+	if l, ok := fn.(*ast.List); ok && len(l.Items) > 0 {
+		fn = l.Items[0]
+	}
+	call, _ := fn.(*ast.FuncCall)
+	return call
+}
+
+// GetFuncTable returns the rows returned by a function in a FROM clause. The
+// columns are the OUT or TABLE arguments of the function, the columns of the
+// composite type it returns or, for a function returning a scalar, a single
+// column named after the function.
+func (qc QueryCatalog) GetFuncTable(n *ast.RangeFunction) (*Table, error) {
+	call := rangeFunctionCall(n)
+	if call == nil || call.Func == nil {
+		return nil, fmt.Errorf("unsupported function source")
+	}
+	fun, err := qc.catalog.ResolveFuncCall(call)
+	if err != nil {
+		return nil, err
+	}
+	if args, err := exprColumns(&qc, nil, nil, call.Args); err == nil {
+		fun = funcOverload(&qc, call, fun, args, "")
+	}
+
+	name := call.Func.Name
+	if n.Alias != nil && n.Alias.Aliasname != nil {
+		name = *n.Alias.Aliasname
+	}
+	rel := &ast.TableName{Name: name}
+
+	var cols []*Column
+	if out := fun.OutArgs(); len(out) > 0 {
+		for _, arg := range out {
+			cols = append(cols, &Column{
+				Table:    rel,
+				Name:     arg.Name,
+				DataType: dataType(arg.Type),
+				IsArray:  arg.Type.ArrayBounds != nil,
+				Type:     arg.Type,
+			})
+		}
+	} else if n.Coldeflist != nil && len(n.Coldeflist.Items) > 0 {
+		// A function returning record is given its columns by the query
+		for _, item := range n.Coldeflist.Items {
+			def, ok := item.(*ast.ColumnDef)
+			if !ok {
+				continue
+			}
+			cols = append(cols, &Column{
+				Table:    rel,
+				Name:     def.Colname,
+				DataType: dataType(def.TypeName),
+				IsArray:  def.IsArray || def.TypeName.ArrayBounds != nil,
+				Type:     def.TypeName,
+			})
+		}
+	} else if fun.ReturnType != nil {
+		table, err := qc.GetTable(&ast.TableName{
+			Catalog: fun.ReturnType.Catalog,
+			Schema:  fun.ReturnType.Schema,
+			Name:    fun.ReturnType.Name,
+		})
+		if err == nil {
+			for _, col := range table.Columns {
+				c := *col
+				cols = append(cols, &c)
+			}
+		} else {
+			cols = append(cols, &Column{
+				Table:    rel,
+				Name:     name,
+				DataType: dataType(fun.ReturnType),
+				NotNull:  !fun.ReturnTypeNullable,
+				IsArray:  fun.ReturnType.ArrayBounds != nil,
+				Type:     fun.ReturnType,
+			})
+		}
+	}
+	if len(cols) == 0 {
+		return nil, fmt.Errorf("function %s returns no columns", call.Func.Name)
+	}
+
+	if n.Ordinality {
+		cols = append(cols, &Column{
+			Table:    rel,
+			Name:     "ordinality",
+			DataType: "bigint",
+			NotNull:  true,
+		})
+	}
+	if n.Alias != nil && n.Alias.Colnames != nil {
+		for i, item := range n.Alias.Colnames.Items {
+			if i >= len(cols) {
+				break
+			}
+			if s, ok := item.(*ast.String); ok {
+				cols[i].Name = s.Str
+			}
+		}
+	}
+	return &Table{Rel: rel, Columns: cols}, nil
+}
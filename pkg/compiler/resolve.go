@@ -19,7 +19,7 @@ func dataType(n *ast.TypeName) string {
 	}
 }
 
-func (comp *Compiler) resolveCatalogRefs(qc *QueryCatalog, rvs []*ast.RangeVar, args []paramRef, params *named.ParamSet) ([]Parameter, error) {
+func (comp *Compiler) resolveCatalogRefs(qc *QueryCatalog, stmt ast.Node, rvs []*ast.RangeVar, args []paramRef, params *named.ParamSet) ([]Parameter, error) {
 	c := comp.catalog
 
 	aliasMap := map[string]*ast.TableName{}
@@ -48,6 +48,19 @@ func (comp *Compiler) resolveCatalogRefs(qc *QueryCatalog, rvs []*ast.RangeVar,
 		return nil
 	}
 
+	// inSchema reports whether a table belongs to the schema which a column
+	// reference names
+	inSchema := func(fqn *ast.TableName, schema string) bool {
+		if schema == "" {
+			return true
+		}
+		if fqn.Schema == "" {
+			return schema == c.DefaultSchema
+		}
+		return fqn.Schema == schema
+	}
+
+	indexed := map[string]bool{}
 	for _, rv := range rvs {
 		if rv.Relname == nil {
 			continue
@@ -62,17 +75,73 @@ func (comp *Compiler) resolveCatalogRefs(qc *QueryCatalog, rvs []*ast.RangeVar,
 		table, err := c.GetTable(fqn)
 		if err != nil {
 			// If the table name doesn't exist, fisrt check if it's a CTE
-			if _, qcerr := qc.GetTable(fqn); qcerr != nil {
+			cte, qcerr := qc.GetTable(fqn)
+			if qcerr != nil {
+				return nil, err
+			}
+			table = catalogTable(cte)
+		}
+		// A table which is referenced more than once, as by a self join or
+		// a recursive query, is only searched once
+		if key := fqn.Schema + "." + fqn.Name; !indexed[key] {
+			indexed[key] = true
+			err = indexTable(table)
+			if err != nil {
 				return nil, err
 			}
+		}
+		if rv.Alias != nil {
+			aliasMap[*rv.Alias.Aliasname] = fqn
+		}
+	}
+
+	// Functions in a FROM clause are searched by their alias or name
+	funcs := astutils.Search(stmt, func(node ast.Node) bool {
+		_, ok := node.(*ast.RangeFunction)
+		return ok
+	})
+	for _, item := range funcs.Items {
+		table, err := qc.GetFuncTable(item.(*ast.RangeFunction))
+		if err != nil {
 			continue
 		}
-		err = indexTable(table)
+		if key := "." + table.Rel.Name; !indexed[key] {
+			indexed[key] = true
+			if err := indexTable(catalogTable(table)); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	// The conflict clause of an upsert refers to the row proposed for
+	// insertion as a row of the insert target
+	if insert, ok := stmt.(*ast.InsertStmt); ok && insert.OnConflictClause != nil {
+		fqn, err := ParseTableName(insert.Relation)
 		if err != nil {
 			return nil, err
 		}
-		if rv.Alias != nil {
-			aliasMap[*rv.Alias.Aliasname] = fqn
+		table, err := c.GetTable(fqn)
+		if err != nil {
+			return nil, err
+		}
+		if err := checkUpsert(table, insert); err != nil {
+			return nil, err
+		}
+		if _, found := aliasMap[excludedAlias]; !found {
+			aliasMap[excludedAlias] = fqn
+		}
+		// MySQL's row alias names the proposed row, and its column aliases
+		// may be referenced unqualified
+		if alias := insert.OnConflictClause.RowAlias; alias != nil && alias.Colnames == nil {
+			aliasMap[*alias.Aliasname] = fqn
+		} else if alias != nil {
+			target := &Table{Rel: fqn}
+			for _, col := range table.Columns {
+				target.Columns = append(target.Columns, ConvertColumn(fqn, col))
+			}
+			if err := indexTable(catalogTable(rowAliasTable(target, insert))); err != nil {
+				return nil, err
+			}
 		}
 	}
 
@@ -120,6 +189,10 @@ func (comp *Compiler) resolveCatalogRefs(qc *QueryCatalog, rvs []*ast.RangeVar,
 				if astutils.Join(n.Name, ".") == "||" {
 					dataType = "string"
 				}
+				if dataType == "any" {
+					a = append(a, inferredParameter(qc, stmt, ref, params))
+					continue
+				}
 
 				defaultP := named.NewParam("")
 				p, isNamed := params.FetchMerge(ref.ref.Number, defaultP)
@@ -138,25 +211,29 @@ func (comp *Compiler) resolveCatalogRefs(qc *QueryCatalog, rvs []*ast.RangeVar,
 			switch left := list.Items[0].(type) {
 			case *ast.ColumnRef:
 				items := stringSlice(left.Fields)
-				var key, alias string
+				var key, alias, schema string
 				switch len(items) {
 				case 1:
 					key = items[0]
 				case 2:
 					alias = items[0]
 					key = items[1]
+				case 3:
+					schema = items[0]
+					alias = items[1]
+					key = items[2]
 				default:
 					panic("too many field items: " + strconv.Itoa(len(items)))
 				}
 
-				search := tables
+				search := scopeTables(stmt, ref.ref, tables)
 				if alias != "" {
-					if original, ok := aliasMap[alias]; ok {
+					if original, ok := aliasMap[alias]; ok && schema == "" {
 						search = []*ast.TableName{original}
 					} else {
 						var located bool
 						for _, fqn := range tables {
-							if fqn.Name == alias {
+							if fqn.Name == alias && inSchema(fqn, schema) {
 								located = true
 								search = []*ast.TableName{fqn}
 							}
@@ -193,6 +270,7 @@ func (comp *Compiler) resolveCatalogRefs(qc *QueryCatalog, rvs []*ast.RangeVar,
 								NotNull:      p.NotNull(),
 								IsArray:      c.IsArray,
 								Length:       c.Length,
+								Collation:    c.Collation,
 								Table:        table,
 								IsNamedParam: isNamed,
 							},
@@ -259,7 +337,11 @@ func (comp *Compiler) resolveCatalogRefs(qc *QueryCatalog, rvs []*ast.RangeVar,
 			}
 
 		case *ast.FuncCall:
-			fun, err := c.ResolveFuncCall(n)
+			resolveFunc, argsOf := c.ResolveFuncCall, (*catalog.Function).InArgs
+			if call, ok := stmt.(*ast.CallStmt); ok && call.FuncCall == n {
+				resolveFunc, argsOf = c.ResolveCall, (*catalog.Function).CallArgs
+			}
+			fun, err := resolveFunc(n)
 			if err != nil {
 				// Synthesize a function on the fly to avoid returning with an error
 				// for an unknown Postgres function (e.g. defined in an extension)
@@ -329,8 +411,13 @@ func (comp *Compiler) resolveCatalogRefs(qc *QueryCatalog, rvs []*ast.RangeVar,
 				var paramName string
 				var paramType *ast.TypeName
 				if argName == "" {
-					paramName = fun.Args[i].Name
-					paramType = fun.Args[i].Type
+					args := argsOf(fun)
+					if i >= len(args) {
+						// The trailing arguments of a variadic function
+						i = len(args) - 1
+					}
+					paramName = args[i].Name
+					paramType = args[i].Type
 				} else {
 					paramName = argName
 					for _, arg := range fun.Args {
@@ -348,14 +435,21 @@ func (comp *Compiler) resolveCatalogRefs(qc *QueryCatalog, rvs []*ast.RangeVar,
 
 				defaultP := named.NewInferredParam(paramName, true)
 				p, isNamed := params.FetchMerge(ref.ref.Number, defaultP)
+				col := &Column{
+					Name:         p.Name(),
+					DataType:     dataType(paramType),
+					NotNull:      p.NotNull(),
+					IsNamedParam: isNamed,
+				}
+				// The declared type of the overload which matches the other
+				// arguments
+				if expected := inferParam(qc, stmt, ref.ref); expected != nil && argName == "" {
+					col.DataType = expected.DataType
+					col.IsArray = expected.IsArray
+				}
 				a = append(a, Parameter{
 					Number: ref.ref.Number,
-					Column: &Column{
-						Name:         p.Name(),
-						DataType:     dataType(paramType),
-						NotNull:      p.NotNull(),
-						IsNamedParam: isNamed,
-					},
+					Column: col,
 				})
 			}
 
@@ -379,7 +473,8 @@ func (comp *Compiler) resolveCatalogRefs(qc *QueryCatalog, rvs []*ast.RangeVar,
 
 		case *ast.ResTarget:
 			if n.Name == nil {
-				return nil, fmt.Errorf("*ast.ResTarget has nil name")
+				a = append(a, inferredParameter(qc, stmt, ref, params))
+				continue
 			}
 			key := *n.Name
 
@@ -418,6 +513,7 @@ func (comp *Compiler) resolveCatalogRefs(qc *QueryCatalog, rvs []*ast.RangeVar,
 						IsArray:      c.IsArray,
 						Table:        &ast.TableName{Schema: schema, Name: rel},
 						Length:       c.Length,
+						Collation:    c.Collation,
 						IsNamedParam: isNamed,
 					},
 				})
@@ -447,6 +543,38 @@ func (comp *Compiler) resolveCatalogRefs(qc *QueryCatalog, rvs []*ast.RangeVar,
 		case *ast.ParamRef:
 			a = append(a, Parameter{Number: ref.ref.Number})
 
+		case *ast.BoolExpr, *ast.CaseExpr, *ast.CoalesceExpr, *ast.MinMaxExpr, *ast.RowExpr:
+			a = append(a, inferredParameter(qc, stmt, ref, params))
+
+		case *ast.WindowDef:
+			var name string
+			switch ref.ref {
+			case n.StartOffset:
+				name = "start_offset"
+			case n.EndOffset:
+				name = "end_offset"
+			default:
+				a = append(a, Parameter{Number: ref.ref.Number})
+				continue
+			}
+			// The offset of a RANGE frame has the type of the ORDER BY
+			// column, while ROWS and GROUPS count rows
+			dataType := "bigint"
+			if n.FrameOptions&ast.FrameOptionRange != 0 {
+				dataType = "any"
+			}
+			defaultP := named.NewInferredParam(name, true)
+			p, isNamed := params.FetchMerge(ref.ref.Number, defaultP)
+			a = append(a, Parameter{
+				Number: ref.ref.Number,
+				Column: &Column{
+					Name:         p.Name(),
+					DataType:     dataType,
+					NotNull:      p.NotNull(),
+					IsNamedParam: isNamed,
+				},
+			})
+
 		case *ast.In:
 			if n == nil || n.List == nil {
 				fmt.Println("ast.In is nil")
@@ -459,7 +587,7 @@ func (comp *Compiler) resolveCatalogRefs(qc *QueryCatalog, rvs []*ast.RangeVar,
 			}
 
 			location := 0
-			var key, alias string
+			var key, alias, schema string
 			var items []string
 
 			if left, ok := n.Expr.(*ast.ColumnRef); ok {
@@ -485,19 +613,23 @@ func (comp *Compiler) resolveCatalogRefs(qc *QueryCatalog, rvs []*ast.RangeVar,
 			case 2:
 				alias = items[0]
 				key = items[1]
+			case 3:
+				schema = items[0]
+				alias = items[1]
+				key = items[2]
 			default:
 				panic("too many field items: " + strconv.Itoa(len(items)))
 			}
 
 			var found int
 			if n.Sel == nil {
-				search := tables
+				search := scopeTables(stmt, ref.ref, tables)
 				if alias != "" {
-					if original, ok := aliasMap[alias]; ok {
+					if original, ok := aliasMap[alias]; ok && schema == "" {
 						search = []*ast.TableName{original}
 					} else {
 						for _, fqn := range tables {
-							if fqn.Name == alias {
+							if fqn.Name == alias && inSchema(fqn, schema) {
 								search = []*ast.TableName{fqn}
 							}
 						}
@@ -554,3 +686,44 @@ func (comp *Compiler) resolveCatalogRefs(qc *QueryCatalog, rvs []*ast.RangeVar,
 	}
 	return a, nil
 }
+
+// scopeTables returns the tables named in the FROM clause of the innermost
+// query containing the parameter, so that a column of another query of the
+// statement does not make a reference ambiguous. All tables are returned when
+// the query names none of them.
+func scopeTables(root ast.Node, ref *ast.ParamRef, tables []*ast.TableName) []*ast.TableName {
+	v := &paramPath{target: ref}
+	astutils.Walk(v, root)
+	for i := len(v.path) - 1; i >= 0; i-- {
+		sel, ok := v.path[i].(*ast.SelectStmt)
+		if !ok || sel.FromClause == nil || len(sel.FromClause.Items) == 0 {
+			continue
+		}
+		names := map[string]bool{}
+		astutils.Walk(astutils.VisitorFunc(func(node ast.Node) {
+			switch n := node.(type) {
+			case *ast.RangeVar:
+				if n.Relname != nil {
+					names[*n.Relname] = true
+				}
+			case *ast.RangeFunction:
+				if n.Alias != nil && n.Alias.Aliasname != nil {
+					names[*n.Alias.Aliasname] = true
+				} else if call := rangeFunctionCall(n); call != nil && call.Func != nil {
+					names[call.Func.Name] = true
+				}
+			}
+		}), sel.FromClause)
+		var scope []*ast.TableName
+		for _, table := range tables {
+			if names[table.Name] {
+				scope = append(scope, table)
+			}
+		}
+		if len(scope) > 0 {
+			return scope
+		}
+		break
+	}
+	return tables
+}
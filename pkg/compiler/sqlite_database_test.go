@@ -0,0 +1,38 @@
+//go:build cgo
+
+package compiler
+
+import (
+	"database/sql"
+	"path/filepath"
+	"testing"
+
+	"github.com/stephenwithav/sqlc/pkg/config"
+	"github.com/stephenwithav/sqlc/pkg/sql/ast"
+)
+
+func TestSQLiteDatabaseRelativeToConfig(t *testing.T) {
+	dir := t.TempDir()
+	db, err := sql.Open("sqlite3", filepath.Join(dir, "app.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.Exec("CREATE TABLE authors (id integer PRIMARY KEY)"); err != nil {
+		t.Fatal(err)
+	}
+	db.Close()
+
+	conf := config.SQL{
+		Engine:        config.EngineSQLite,
+		SchemaSources: []config.Source{{Database: true}},
+	}
+	c := NewCompiler(conf, config.CombinedSettings{
+		Global: config.Config{Path: filepath.Join(dir, "sqlc.yaml")},
+	})
+	if err := c.ParseCatalog([]string{"app.db"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.Catalog().GetTable(&ast.TableName{Name: "authors"}); err != nil {
+		t.Error(err)
+	}
+}
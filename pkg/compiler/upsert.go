@@ -0,0 +1,177 @@
+package compiler
+
+import (
+	"fmt"
+
+	"github.com/stephenwithav/sqlc/pkg/sql/ast"
+	"github.com/stephenwithav/sqlc/pkg/sql/astutils"
+	"github.com/stephenwithav/sqlc/pkg/sql/catalog"
+	"github.com/stephenwithav/sqlc/pkg/sql/sqlerr"
+)
+
+// excludedAlias is the name by which the ON CONFLICT clause of PostgreSQL
+// and SQLite refers to the row proposed for insertion.
+const excludedAlias = "excluded"
+
+// checkUpsert returns an error if the conflict target, the assignments or
+// the references to the proposed row of an upsert name a column which the
+// insert target does not have. MySQL refers to the proposed row with
+// VALUES(col) or by its row alias.
+func checkUpsert(table catalog.Table, n *ast.InsertStmt) error {
+	clause := n.OnConflictClause
+	columns := map[string]bool{}
+	var names []string
+	for _, col := range table.Columns {
+		columns[col.Name] = true
+		names = append(names, col.Name)
+	}
+	var alias string
+	aliasColumns := rowAliasColumns(n, names)
+	if clause.RowAlias != nil {
+		alias = *clause.RowAlias.Aliasname
+	}
+
+	if clause.Infer != nil && clause.Infer.IndexElems != nil {
+		for _, item := range clause.Infer.IndexElems.Items {
+			elem, ok := item.(*ast.IndexElem)
+			if !ok || elem.Name == nil || columns[*elem.Name] {
+				continue
+			}
+			return &sqlerr.Error{
+				Code:     "42703",
+				Message:  fmt.Sprintf("column \"%s\" does not exist", *elem.Name),
+				Location: clause.Infer.Location,
+			}
+		}
+	}
+
+	if clause.TargetList != nil {
+		for _, item := range clause.TargetList.Items {
+			target, ok := item.(*ast.ResTarget)
+			if !ok || target.Name == nil || columns[*target.Name] {
+				continue
+			}
+			return &sqlerr.Error{
+				Code:     "42703",
+				Message:  fmt.Sprintf("column \"%s\" of relation \"%s\" does not exist", *target.Name, table.Rel.Name),
+				Location: target.Location,
+			}
+		}
+	}
+
+	var err error
+	astutils.Walk(astutils.VisitorFunc(func(node ast.Node) {
+		if err != nil {
+			return
+		}
+		switch n := node.(type) {
+		case *ast.ColumnRef:
+			parts := stringSlice(n.Fields)
+			if len(parts) != 2 {
+				return
+			}
+			if parts[0] == excludedAlias && !columns[parts[1]] || alias != "" && parts[0] == alias && aliasColumns[parts[1]] == "" {
+				err = &sqlerr.Error{
+					Code:     "42703",
+					Message:  fmt.Sprintf("column %s.%s does not exist", parts[0], parts[1]),
+					Location: n.Location,
+				}
+			}
+		case *ast.FuncCall:
+			if n.Func == nil || n.Func.Schema != "" || n.Func.Name != "values" || len(n.Args.Items) != 1 {
+				return
+			}
+			ref, ok := n.Args.Items[0].(*ast.ColumnRef)
+			if !ok {
+				return
+			}
+			parts := stringSlice(ref.Fields)
+			if len(parts) == 0 || columns[parts[len(parts)-1]] {
+				return
+			}
+			err = &sqlerr.Error{
+				Code:     "42703",
+				Message:  fmt.Sprintf("column \"%s\" does not exist", parts[len(parts)-1]),
+				Location: n.Location,
+			}
+		}
+	}), clause)
+	return err
+}
+
+// excludedTable returns the row proposed for insertion into a table by an
+// upsert.
+func excludedTable(table *Table) *Table {
+	return &Table{
+		Rel:       &ast.TableName{Name: excludedAlias},
+		Columns:   table.Columns,
+		qualified: true,
+	}
+}
+
+// rowAliasColumns maps the column names of MySQL's row alias of an upsert to
+// the columns of the insert target. Without a column list the alias keeps
+// the names of the columns; with one it renames the inserted columns in
+// order.
+func rowAliasColumns(n *ast.InsertStmt, columns []string) map[string]string {
+	names := map[string]string{}
+	alias := n.OnConflictClause.RowAlias
+	if alias == nil {
+		return names
+	}
+	if alias.Colnames == nil {
+		for _, name := range columns {
+			names[name] = name
+		}
+		return names
+	}
+	if n.Cols != nil && len(n.Cols.Items) > 0 {
+		columns = nil
+		for _, item := range n.Cols.Items {
+			if target, ok := item.(*ast.ResTarget); ok && target.Name != nil {
+				columns = append(columns, *target.Name)
+			}
+		}
+	}
+	for i, name := range stringSlice(alias.Colnames) {
+		if i < len(columns) {
+			names[name] = columns[i]
+		}
+	}
+	return names
+}
+
+// rowAliasTable returns the row proposed for insertion into a table by a
+// MySQL upsert under its row alias, or nil if it has none. Columns renamed
+// by the alias may be referenced unqualified.
+func rowAliasTable(table *Table, n *ast.InsertStmt) *Table {
+	alias := n.OnConflictClause.RowAlias
+	if alias == nil {
+		return nil
+	}
+	aliasTable := &Table{
+		Rel:       &ast.TableName{Name: *alias.Aliasname},
+		Columns:   table.Columns,
+		qualified: true,
+	}
+	if alias.Colnames == nil {
+		return aliasTable
+	}
+	var names []string
+	for _, col := range table.Columns {
+		names = append(names, col.Name)
+	}
+	renamed := rowAliasColumns(n, names)
+	aliasTable.Columns = nil
+	aliasTable.qualified = false
+	for _, name := range stringSlice(alias.Colnames) {
+		for _, col := range table.Columns {
+			if col.Name == renamed[name] {
+				c := *col
+				c.Name = name
+				aliasTable.Columns = append(aliasTable.Columns, &c)
+			}
+		}
+	}
+	return aliasTable
+}
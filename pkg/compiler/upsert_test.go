@@ -0,0 +1,86 @@
+package compiler
+
+import (
+	"testing"
+
+	"github.com/stephenwithav/sqlc/pkg/config"
+)
+
+func TestUpsert(t *testing.T) {
+	schema := "CREATE TABLE authors (id integer PRIMARY KEY, name text NOT NULL, bio text, hits integer NOT NULL);"
+	testQueries(t, schema, []queryTest{
+		{
+			engine: config.EnginePostgreSQL,
+			query:  "INSERT INTO authors (id, name, hits) VALUES ($1, $2, 1) ON CONFLICT (id) DO UPDATE SET hits = authors.hits + $3, bio = coalesce(excluded.bio, $4) WHERE excluded.name <> $5 RETURNING id, hits",
+			cols:   []column{{"id", "pg_catalog.int4", true}, {"hits", "pg_catalog.int4", true}},
+			params: []column{{"id", "pg_catalog.int4", true}, {"name", "text", true}, {"hits", "pg_catalog.int4", true}, {"bio", "text", false}, {"name", "text", true}},
+		},
+		{
+			engine: config.EnginePostgreSQL,
+			query:  "INSERT INTO authors AS a (id, name, hits) VALUES ($1, $2, 1) ON CONFLICT ON CONSTRAINT authors_pkey DO UPDATE SET (name, bio) = ($3, $4) RETURNING *",
+			cols:   []column{{"id", "pg_catalog.int4", true}, {"name", "text", true}, {"bio", "text", false}, {"hits", "pg_catalog.int4", true}},
+			params: []column{{"id", "pg_catalog.int4", true}, {"name", "text", true}, {"name", "text", true}, {"bio", "text", false}},
+		},
+		{
+			engine: config.EnginePostgreSQL,
+			query:  "INSERT INTO authors (id, name, hits) VALUES ($1, $2, 1) ON CONFLICT (id) DO UPDATE SET name = excluded.nme",
+			err:    "column excluded.nme does not exist",
+		},
+		{
+			engine: config.EnginePostgreSQL,
+			query:  "INSERT INTO authors (id, name, hits) VALUES ($1, $2, 1) ON CONFLICT (idd) DO NOTHING",
+			err:    `column "idd" does not exist`,
+		},
+		{
+			engine: config.EnginePostgreSQL,
+			query:  "INSERT INTO authors (id, name, hits) VALUES ($1, $2, 1) ON CONFLICT (id) DO UPDATE SET name = $3, name = $4",
+			err:    `multiple assignments to same column "name"`,
+		},
+		{
+			engine: config.EngineMySQL,
+			query:  "INSERT INTO authors (id, name, bio, hits) VALUES (?, ?, ?, 0) ON DUPLICATE KEY UPDATE name = VALUES(name), hits = VALUES(hits) + ?, bio = ?",
+			params: []column{{"id", "int", true}, {"name", "text", true}, {"bio", "text", false}, {"hits", "int", true}, {"bio", "text", false}},
+		},
+		{
+			engine: config.EngineMySQL,
+			query:  "INSERT INTO authors (id, name, hits) VALUES (?, ?, 1) ON DUPLICATE KEY UPDATE nme = VALUES(name)",
+			err:    `column "nme" of relation "authors" does not exist`,
+		},
+		{
+			engine: config.EngineMySQL,
+			query:  "INSERT INTO authors (id, name, hits) VALUES (?, ?, 1) ON DUPLICATE KEY UPDATE name = VALUES(nme)",
+			err:    `column "nme" does not exist`,
+		},
+		{
+			engine: config.EngineMySQL,
+			query:  "INSERT INTO authors (id, name, bio, hits) VALUES (?, ?, ?, 0) AS new ON DUPLICATE KEY UPDATE name = new.name, hits = new.hits + ?, bio = coalesce(new.bio, ?)",
+			params: []column{{"id", "int", true}, {"name", "text", true}, {"bio", "text", false}, {"hits", "int", true}, {"bio", "text", false}},
+		},
+		{
+			engine: config.EngineMySQL,
+			query:  "INSERT INTO authors (id, name, hits) VALUES (?, ?, 1) AS new(i, n, h) ON DUPLICATE KEY UPDATE name = n, hits = h + ?",
+			params: []column{{"id", "int", true}, {"name", "text", true}, {"h", "int", true}},
+		},
+		{
+			engine: config.EngineMySQL,
+			query:  "INSERT INTO authors SET id = 1, name = 'a', hits = 1 AS `new` ON DUPLICATE KEY UPDATE name = `new`.name, bio = ?",
+			params: []column{{"bio", "text", false}},
+		},
+		{
+			engine: config.EngineMySQL,
+			query:  "INSERT INTO authors (id, name, hits) VALUES (?, ?, 1) AS new ON DUPLICATE KEY UPDATE name = new.nme",
+			err:    "column new.nme does not exist",
+		},
+		{
+			engine: config.EngineSQLite,
+			query:  "INSERT INTO authors (id, name, bio, hits) VALUES (?, ?, ?, 0) ON CONFLICT (id) WHERE hits > ? DO UPDATE SET name = excluded.name, hits = hits + ? WHERE excluded.bio <> ? RETURNING id, hits",
+			cols:   []column{{"id", "integer", true}, {"hits", "integer", true}},
+			params: []column{{"id", "integer", true}, {"name", "text", true}, {"bio", "text", false}, {"hits", "integer", true}, {"hits", "integer", true}, {"bio", "text", false}},
+		},
+		{
+			engine: config.EngineSQLite,
+			query:  "INSERT INTO authors (id, name, hits) VALUES (?, ?, 1) ON CONFLICT DO UPDATE SET (name, bio) = (?, ?)",
+			params: []column{{"id", "integer", true}, {"name", "text", true}, {"name", "text", true}, {"bio", "text", false}},
+		},
+	})
+}
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"path/filepath"
 
 	"gopkg.in/yaml.v3"
 )
@@ -18,10 +19,24 @@ type Engine string
 
 type Paths []string
 
+// UnmarshalJSON accepts a string or a list of strings. An entry naming a
+// SQLite database is rejected: the JSON decoder can't record which entries
+// name a database, so those configurations must be read with ParseConfig.
 func (p *Paths) UnmarshalJSON(data []byte) error {
+	if string(data[0]) == `{` {
+		return ErrDatabaseJSON
+	}
 	if string(data[0]) == `[` {
 		var out []string
 		if err := json.Unmarshal(data, &out); err != nil {
+			var items []json.RawMessage
+			if json.Unmarshal(data, &items) == nil {
+				for _, item := range items {
+					if len(item) > 0 && item[0] == '{' {
+						return ErrDatabaseJSON
+					}
+				}
+			}
 			return nil
 		}
 		*p = Paths(out)
@@ -35,14 +50,30 @@ func (p *Paths) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
-func (p *Paths) UnmarshalYAML(unmarshal func(interface{}) error) error {
+// An entry is SQL text, or a mapping naming a SQLite database file to read
+// the schema from:
+//
+//	schema:
+//	  - database: app.db
+func (p *Paths) UnmarshalYAML(node *yaml.Node) error {
+	items := []*yaml.Node{node}
+	if node.Kind == yaml.SequenceNode {
+		items = node.Content
+	}
 	out := []string{}
-	if sliceErr := unmarshal(&out); sliceErr != nil {
+	for _, item := range items {
+		if item.Kind == yaml.MappingNode {
+			db := mappingValue(item, "database")
+			if db == nil || len(item.Content) != 2 {
+				return fmt.Errorf("line %d: a mapping entry must only name a database", item.Line)
+			}
+			item = db
+		}
 		var ele string
-		if strErr := unmarshal(&ele); strErr != nil {
-			return strErr
+		if err := item.Decode(&ele); err != nil {
+			return err
 		}
-		out = []string{ele}
+		out = append(out, ele)
 	}
 
 	*p = Paths(out)
@@ -61,6 +92,28 @@ type Config struct {
 	SQL     []SQL    `json:"sql" yaml:"sql"`
 	Gen     Gen      `json:"overrides,omitempty" yaml:"overrides"`
 	Plugins []Plugin `json:"plugins" yaml:"plugins"`
+
+	// Path of the configuration file, when it was read from one. Errors
+	// inside inline SQL are reported against it, and database files named
+	// by a relative path are found from its directory.
+	Path string `json:"-" yaml:"-"`
+}
+
+// Filename returns the name errors inside inline SQL are reported against.
+func (c Config) Filename() string {
+	if c.Path == "" {
+		return DefaultFilename
+	}
+	return c.Path
+}
+
+// Dir returns the directory relative paths in the configuration are
+// resolved against.
+func (c Config) Dir() string {
+	if c.Path == "" {
+		return "."
+	}
+	return filepath.Dir(c.Path)
 }
 
 type Project struct {
@@ -92,8 +145,14 @@ type SQL struct {
 	Schema               Paths     `json:"schema" yaml:"schema"`
 	Queries              Paths     `json:"queries" yaml:"queries"`
 	StrictFunctionChecks bool      `json:"strict_function_checks" yaml:"strict_function_checks"`
+	NullablePrimaryKeys  bool      `json:"nullable_primary_keys,omitempty" yaml:"nullable_primary_keys"`
+	Database             string    `json:"database,omitempty" yaml:"database"`
 	Gen                  SQLGen    `json:"gen" yaml:"gen"`
 	Codegen              []Codegen `json:"codegen" yaml:"codegen"`
+
+	// Where each Schema and Queries entry sits in the configuration file
+	SchemaSources  []Source `json:"-" yaml:"-"`
+	QueriesSources []Source `json:"-" yaml:"-"`
 }
 
 // TODO: Figure out a better name for this
@@ -161,13 +220,19 @@ var ErrPluginProcessNoCmd = errors.New("plugin: missing process command")
 
 var ErrInvalidQueryParameterLimit = errors.New("invalid query parameter limit")
 
+var ErrQueriesDatabase = errors.New("queries can't be read from a database")
+var ErrSchemaDatabaseEngine = errors.New("only the sqlite engine reads a schema from a database")
+var ErrDatabaseJSON = errors.New("a database schema entry can only be decoded from YAML")
+
 func ParseConfig(rd io.Reader) (Config, error) {
-	var buf bytes.Buffer
 	var config Config
 	var version versionSetting
 
-	ver := io.TeeReader(rd, &buf)
-	dec := yaml.NewDecoder(ver)
+	data, err := io.ReadAll(rd)
+	if err != nil {
+		return config, err
+	}
+	dec := yaml.NewDecoder(bytes.NewReader(data))
 	if err := dec.Decode(&version); err != nil {
 		return config, err
 	}
@@ -176,12 +241,31 @@ func ParseConfig(rd io.Reader) (Config, error) {
 	}
 	switch version.Number {
 	case "1":
-		return v1ParseConfig(&buf)
+		config, err = v1ParseConfig(bytes.NewReader(data))
 	case "2":
-		return v2ParseConfig(&buf)
+		config, err = v2ParseConfig(bytes.NewReader(data))
 	default:
 		return config, ErrUnknownVersion
 	}
+	if err != nil {
+		return config, err
+	}
+	if err := config.locateSources(data); err != nil {
+		return config, err
+	}
+	for _, sql := range config.SQL {
+		for _, src := range sql.QueriesSources {
+			if src.Database {
+				return config, ErrQueriesDatabase
+			}
+		}
+		for _, src := range sql.SchemaSources {
+			if src.Database && sql.Engine != EngineSQLite {
+				return config, ErrSchemaDatabaseEngine
+			}
+		}
+	}
+	return config, nil
 }
 
 func Validate(c *Config) error {
@@ -1,6 +1,10 @@
 package config
 
 import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path/filepath"
 	"strings"
 	"testing"
 
@@ -191,3 +195,226 @@ func FuzzOverride(f *testing.F) {
 		o.Parse()
 	})
 }
+
+const inlineSQL = `version: "2"
+sql:
+  - engine: "postgresql"
+    schema: "CREATE TABLE authors (id int);"
+    queries: |
+      -- name: GetAuthor :one
+        SELECT * FROM authors;
+    gen:
+      go:
+        out: "db"
+`
+
+func TestSourcePositions(t *testing.T) {
+	conf, err := ParseConfig(strings.NewReader(inlineSQL))
+	if err != nil {
+		t.Fatal(err)
+	}
+	pkg := conf.SQL[0]
+	for _, tt := range []struct {
+		src       *Source
+		line, col int
+		wantLine  int
+		wantCol   int
+	}{
+		// "CREATE" in the quoted schema string
+		{pkg.SchemaSource(0), 1, 1, 4, 14},
+		// "authors" in the quoted schema string
+		{pkg.SchemaSource(0), 1, 14, 4, 27},
+		// "--" in the queries block
+		{pkg.QueriesSource(0), 1, 1, 6, 7},
+		// "FROM" in the queries block
+		{pkg.QueriesSource(0), 2, 12, 7, 18},
+	} {
+		line, col := tt.src.Translate(tt.line, tt.col)
+		if line != tt.wantLine || col != tt.wantCol {
+			t.Errorf("Translate(%d, %d) = %d:%d; want %d:%d", tt.line, tt.col, line, col, tt.wantLine, tt.wantCol)
+		}
+	}
+	if src := pkg.SchemaSource(1); src != nil {
+		t.Errorf("expected no source for a missing entry; got %v", src)
+	}
+}
+
+const foldedSQL = `
+version: "2"
+sql:
+  - engine: "postgresql"
+    schema: >
+      CREATE TABLE authors (
+        id   BIGSERIAL PRIMARY KEY,
+        name text NOT NULL
+      );
+
+      CREATE TABLE books (id BIGSERIAL PRIMARY KEY,
+      author_id bigint NOT NULL,
+
+
+      title text);
+    queries: "SELECT * FROM authors;"
+    gen:
+      go:
+        out: "db"
+`
+
+func TestFoldedSourcePositions(t *testing.T) {
+	conf, err := ParseConfig(strings.NewReader(foldedSQL))
+	if err != nil {
+		t.Fatal(err)
+	}
+	pkg := conf.SQL[0]
+	src := pkg.SchemaSource(0)
+	sql := strings.Split(pkg.Schema[0], "\n")
+	file := strings.Split(foldedSQL, "\n")
+	// Every character of the SQL text must be found at its translated
+	// position, and translate back to where it came from
+	for i, text := range sql {
+		for j := range text {
+			if text[j] == ' ' {
+				continue
+			}
+			line, col := src.Translate(i+1, j+1)
+			if line < 1 || line > len(file) || col < 1 || col > len(file[line-1]) || file[line-1][col-1] != text[j] {
+				t.Fatalf("Translate(%d, %d) = %d:%d, which doesn't hold %q", i+1, j+1, line, col, text[j])
+			}
+			if l, c, ok := src.Untranslate(line, col); !ok || l != i+1 || c != j+1 {
+				t.Errorf("Untranslate(%d, %d) = %d:%d %v; want %d:%d", line, col, l, c, ok, i+1, j+1)
+			}
+		}
+	}
+}
+
+const flowSQL = `
+version: "2"
+sql:
+  - engine: "postgresql"
+    schema:
+      - CREATE TABLE authors (
+          id BIGSERIAL PRIMARY KEY,
+
+          name text NOT NULL
+        );
+      - "CREATE TABLE books (\"id\" BIGSERIAL PRIMARY KEY,\tname text,
+          title text DEFAULT 'caf\u00e9',\n bio text DEFAULT '\
+          x'
+        );"
+      - 'CREATE TABLE tags (name text DEFAULT ''none'',
+
+          label text);'
+    queries: "SELECT * FROM authors;"
+    gen:
+      go:
+        out: "db"
+`
+
+func TestFlowSourcePositions(t *testing.T) {
+	conf, err := ParseConfig(strings.NewReader(flowSQL))
+	if err != nil {
+		t.Fatal(err)
+	}
+	pkg := conf.SQL[0]
+	file := strings.Split(flowSQL, "\n")
+	for i, schema := range pkg.Schema {
+		src := pkg.SchemaSource(i)
+		// Every character of the SQL text must be found at its translated
+		// position, or at the escape which stands for it, and translate
+		// back to where it came from
+		for k, text := range strings.Split(schema, "\n") {
+			for j := range text {
+				if text[j] == ' ' {
+					continue
+				}
+				line, col := src.Translate(k+1, j+1)
+				if line < 1 || line > len(file) || col < 1 || col > len(file[line-1]) {
+					t.Fatalf("Translate(%d, %d) = %d:%d, which is outside the file", k+1, j+1, line, col)
+				}
+				switch c := file[line-1][col-1]; {
+				case c == text[j]:
+					if l, c, ok := src.Untranslate(line, col); !ok || l != k+1 || c != j+1 {
+						t.Errorf("Untranslate(%d, %d) = %d:%d %v; want %d:%d", line, col, l, c, ok, k+1, j+1)
+					}
+				case c != '\\' && c != '\'':
+					t.Errorf("Translate(%d, %d) = %d:%d, which doesn't hold %q", k+1, j+1, line, col, text[j])
+				}
+			}
+		}
+	}
+
+	// Without a record of the scalar's text, positions fall back to its
+	// start
+	src := Source{Line: 4, Column: 13}
+	if line, col := src.Translate(2, 5); line != 4 || col != 13 {
+		t.Errorf("Translate(2, 5) = %d:%d; want 4:13", line, col)
+	}
+}
+
+const databaseSchema = `
+version: "2"
+sql:
+  - engine: "%s"
+    schema:
+      - database: app.db
+      - "ALTER TABLE authors ADD COLUMN bio text;"
+    queries: "SELECT * FROM authors;"
+    gen:
+      go:
+        out: "db"
+`
+
+func TestDatabaseSchema(t *testing.T) {
+	conf, err := ParseConfig(strings.NewReader(fmt.Sprintf(databaseSchema, EngineSQLite)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	pkg := conf.SQL[0]
+	if diff := cmp.Diff(Paths{"app.db", "ALTER TABLE authors ADD COLUMN bio text;"}, pkg.Schema); diff != "" {
+		t.Errorf("schema differed (-want +got):\n%s", diff)
+	}
+	if src := pkg.SchemaSource(0); src == nil || !src.Database || src.Line != 6 || src.Column != 19 {
+		t.Errorf("unexpected source for the database entry: %v", src)
+	}
+	if src := pkg.SchemaSource(1); src == nil || src.Database {
+		t.Errorf("unexpected source for the SQL entry: %v", src)
+	}
+
+	_, err = ParseConfig(strings.NewReader(fmt.Sprintf(databaseSchema, EngineMySQL)))
+	if err != ErrSchemaDatabaseEngine {
+		t.Errorf("expected %v; got %v", ErrSchemaDatabaseEngine, err)
+	}
+}
+
+func TestDatabaseSchemaJSON(t *testing.T) {
+	for _, blob := range []string{
+		`{"schema": {"database": "app.db"}}`,
+		`{"schema": ["schema.sql", {"database": "app.db"}]}`,
+	} {
+		var pkg SQL
+		if err := json.Unmarshal([]byte(blob), &pkg); !errors.Is(err, ErrDatabaseJSON) {
+			t.Errorf("%s: expected %v; got %v", blob, ErrDatabaseJSON, err)
+		}
+	}
+	var pkg SQL
+	if err := json.Unmarshal([]byte(`{"schema": ["schema.sql"], "queries": "query.sql"}`), &pkg); err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(Paths{"schema.sql"}, pkg.Schema); diff != "" {
+		t.Errorf("schema differed (-want +got):\n%s", diff)
+	}
+}
+
+func TestConfigDir(t *testing.T) {
+	for _, tt := range []struct {
+		path, want string
+	}{
+		{"", "."},
+		{"sqlc.yaml", "."},
+		{filepath.Join("db", "sqlc.yaml"), "db"},
+	} {
+		if dir := (Config{Path: tt.path}).Dir(); dir != tt.want {
+			t.Errorf("Dir() of %q = %q; want %q", tt.path, dir, tt.want)
+		}
+	}
+}
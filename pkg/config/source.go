@@ -0,0 +1,381 @@
+package config
+
+import (
+	"strconv"
+	"strings"
+
+	yaml "gopkg.in/yaml.v3"
+)
+
+// DefaultFilename is the configuration file name errors inside inline SQL are
+// reported against when the configuration wasn't read from a known path.
+const DefaultFilename = "sqlc.yaml"
+
+// Source records where an inline schema or queries entry sits in the
+// configuration file, so that positions inside the SQL text can be reported
+// in file coordinates.
+type Source struct {
+	// Line and Column of the YAML scalar, 1-based. For block scalars this
+	// is the position of the | or > indicator.
+	Line   int
+	Column int
+
+	// Indent is the indentation of the SQL text inside a block scalar
+	Indent int
+
+	Style yaml.Style
+
+	// Database is set for an entry which names a SQLite database file
+	// instead of holding SQL
+	Database bool
+
+	// runs records where each run of text copied unchanged from the
+	// configuration file starts in the SQL text, for every scalar but a
+	// literal block scalar, whose lines are copied whole
+	runs []run
+}
+
+// A run is a stretch of SQL text which appears unchanged in the
+// configuration file. Folded line breaks and escapes lie between runs.
+type run struct {
+	fileLine, fileCol int // in the configuration file
+	line, col         int // where the run starts in the SQL text
+	n                 int // length in bytes
+}
+
+// IsBlock reports whether the SQL text is a literal or folded block scalar,
+// where every line of SQL sits on its own line of the file.
+func (s Source) IsBlock() bool {
+	return s.Style&(yaml.LiteralStyle|yaml.FoldedStyle) != 0
+}
+
+// IsFolded reports whether the SQL text is a folded block scalar, where
+// consecutive lines are joined by spaces.
+func (s Source) IsFolded() bool {
+	return s.Style&yaml.FoldedStyle != 0
+}
+
+// Translate converts a 1-based line and column inside the SQL text into a
+// 1-based line and column in the configuration file.
+func (s Source) Translate(line, col int) (int, int) {
+	if line < 1 {
+		line = 1
+	}
+	if col < 1 {
+		col = 1
+	}
+	if s.IsBlock() && !s.IsFolded() {
+		return s.Line + line, s.Indent + col
+	}
+	return s.translateRuns(line, col)
+}
+
+// translateRuns finds the run which holds the position. A position inside
+// the text of an escape or a folded line break is reported where the escape
+// or line break starts, and one before any run falls back to the start of
+// the scalar.
+func (s Source) translateRuns(line, col int) (int, int) {
+	var found *run
+	for i := range s.runs {
+		r := &s.runs[i]
+		if r.line < line || r.line == line && r.col <= col {
+			found = r
+		}
+	}
+	switch {
+	case found != nil && found.line == line && col-found.col < found.n:
+		return found.fileLine, found.fileCol + col - found.col
+	case found != nil:
+		return found.fileLine, found.fileCol + found.n
+	case s.IsBlock():
+		return s.Line + 1, s.Indent + 1
+	default:
+		return s.Line, s.start()
+	}
+}
+
+// Untranslate converts a 1-based line and column in the configuration file
+// into a 1-based line and column inside the SQL text. It reports false when
+// the line holds none of the entry's text.
+func (s Source) Untranslate(line, col int) (int, int, bool) {
+	if s.IsBlock() && !s.IsFolded() {
+		return line - s.Line, col - s.Indent, line > s.Line
+	}
+	var found *run
+	for i := range s.runs {
+		r := &s.runs[i]
+		if r.fileLine == line && (found == nil || r.fileCol <= col) {
+			found = r
+		}
+	}
+	if found == nil {
+		return 0, 0, false
+	}
+	offset := col - found.fileCol
+	if offset < 0 {
+		offset = 0
+	}
+	if offset > found.n {
+		offset = found.n
+	}
+	return found.line, found.col + offset, true
+}
+
+// start is the column of the first SQL character on the first line.
+func (s Source) start() int {
+	if s.Style&(yaml.SingleQuotedStyle|yaml.DoubleQuotedStyle) != 0 {
+		return s.Column + 1
+	}
+	return s.Column
+}
+
+// locateSources records the position of every schema and queries entry. The
+// packages are matched to the nodes by their index in the sql (version 2)
+// or packages (version 1) list.
+func (c *Config) locateSources(data []byte) error {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return err
+	}
+	if len(doc.Content) == 0 {
+		return nil
+	}
+	lines := strings.Split(string(data), "\n")
+	pkgs := mappingValue(doc.Content[0], "sql")
+	if pkgs == nil {
+		pkgs = mappingValue(doc.Content[0], "packages")
+	}
+	if pkgs == nil || pkgs.Kind != yaml.SequenceNode {
+		return nil
+	}
+	for i, pkg := range pkgs.Content {
+		if i >= len(c.SQL) {
+			break
+		}
+		c.SQL[i].SchemaSources = scalarSources(mappingValue(pkg, "schema"), lines)
+		c.SQL[i].QueriesSources = scalarSources(mappingValue(pkg, "queries"), lines)
+	}
+	return nil
+}
+
+func mappingValue(node *yaml.Node, key string) *yaml.Node {
+	if node == nil || node.Kind != yaml.MappingNode {
+		return nil
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i+1]
+		}
+	}
+	return nil
+}
+
+func scalarSources(node *yaml.Node, lines []string) []Source {
+	if node == nil {
+		return nil
+	}
+	switch node.Kind {
+	case yaml.ScalarNode, yaml.MappingNode:
+		return []Source{newSource(node, lines)}
+	case yaml.SequenceNode:
+		var sources []Source
+		for _, item := range node.Content {
+			sources = append(sources, newSource(item, lines))
+		}
+		return sources
+	default:
+		return nil
+	}
+}
+
+func newSource(node *yaml.Node, lines []string) Source {
+	if node.Kind == yaml.MappingNode {
+		if db := mappingValue(node, "database"); db != nil {
+			src := newSource(db, lines)
+			src.Database = true
+			return src
+		}
+	}
+	src := Source{
+		Line:   node.Line,
+		Column: node.Column,
+		Style:  node.Style,
+	}
+	if src.IsBlock() {
+		// The text starts on the line after the indicator and is indented
+		// as far as its first non-empty line
+		for i := node.Line; i < len(lines); i++ {
+			if strings.TrimSpace(lines[i]) == "" {
+				continue
+			}
+			src.Indent = len(lines[i]) - len(strings.TrimLeft(lines[i], " "))
+			break
+		}
+	}
+	switch {
+	case src.IsFolded():
+		src.runs = folds(lines[node.Line:], node.Line, src.Indent)
+	case !src.IsBlock() && node.Kind == yaml.ScalarNode:
+		src.runs = flowRuns(lines, node, src.start())
+	}
+	return src
+}
+
+// folds follows the folding of a block scalar's lines: a line break between
+// two lines of text becomes a space, unless either line is indented further,
+// and each empty line stands for a line break of its own.
+func folds(lines []string, offset, indent int) []run {
+	var out []run
+	line, end, empty := 1, 0, 0
+	prevMore := false
+	for i, text := range lines {
+		if strings.TrimSpace(text) == "" {
+			empty++
+			continue
+		}
+		if len(text)-len(strings.TrimLeft(text, " ")) < indent {
+			break
+		}
+		content := text[indent:]
+		more := content[0] == ' ' || content[0] == '\t'
+		col := 1
+		switch {
+		case out == nil:
+			line += empty
+		case empty == 0 && !more && !prevMore:
+			col = end + 1
+		case !more && !prevMore:
+			line += empty
+		default:
+			line += empty + 1
+		}
+		out = append(out, run{fileLine: offset + i + 1, fileCol: indent + 1, line: line, col: col, n: len(content)})
+		end = col + len(content)
+		empty = 0
+		prevMore = more
+	}
+	return out
+}
+
+// flowRuns follows a plain or quoted scalar through the configuration file.
+// Its line breaks fold like those of a folded block scalar, dropping the
+// whitespace around them, and the escapes of a quoted scalar are decoded.
+// It returns nil if the file doesn't hold the scalar's text.
+func flowRuns(lines []string, node *yaml.Node, start int) []run {
+	value := node.Value
+	double := node.Style&yaml.DoubleQuotedStyle != 0
+	single := node.Style&yaml.SingleQuotedStyle != 0
+	i, j := node.Line-1, start-1
+	line, col := 1, 1
+	var out []run
+	cur := run{fileLine: i + 1, fileCol: j + 1, line: line, col: col}
+	for k := 0; k < len(value); {
+		if i >= len(lines) {
+			return nil
+		}
+		var rest string
+		if j < len(lines[i]) {
+			rest = lines[i][j:]
+		}
+		switch {
+		case strings.TrimRight(rest, " \t\r") == "" || double && strings.TrimRight(rest, "\r") == "\\":
+			// An escaped line break is dropped; any other becomes a
+			// space, unless empty lines follow
+			escaped := rest != "" && rest[0] == '\\'
+			empty := 0
+			for i++; i < len(lines) && strings.TrimSpace(lines[i]) == ""; i++ {
+				empty++
+			}
+			switch {
+			case i >= len(lines):
+				return nil
+			case empty > 0:
+				if !strings.HasPrefix(value[k:], strings.Repeat("\n", empty)) {
+					return nil
+				}
+				k += empty
+				line, col = line+empty, 1
+			case escaped:
+			case value[k] == ' ':
+				k++
+				col++
+			default:
+				return nil
+			}
+			out = append(out, cur)
+			j = len(lines[i]) - len(strings.TrimLeft(lines[i], " \t"))
+			cur = run{fileLine: i + 1, fileCol: j + 1, line: line, col: col}
+		case double && rest[0] == '\\' || single && strings.HasPrefix(rest, "''"):
+			r, size, ok := '\'', 2, true
+			if double {
+				r, size, ok = unescape(rest)
+			}
+			text := string(r)
+			if !ok || !strings.HasPrefix(value[k:], text) {
+				return nil
+			}
+			k += len(text)
+			j += size
+			if r == '\n' {
+				line, col = line+1, 1
+			} else {
+				col += len(text)
+			}
+			out = append(out, cur)
+			cur = run{fileLine: i + 1, fileCol: j + 1, line: line, col: col}
+		case rest[0] == value[k]:
+			k++
+			j++
+			col++
+			cur.n++
+		default:
+			return nil
+		}
+	}
+	return append(out, cur)
+}
+
+// escapes are the single-character escapes of double-quoted scalars.
+var escapes = map[byte]rune{
+	'0': 0, 'a': '\a', 'b': '\b', 't': '\t', '\t': '\t', 'n': '\n', 'v': '\v',
+	'f': '\f', 'r': '\r', 'e': 0x1b, ' ': ' ', '"': '"', '/': '/', '\\': '\\',
+	'N': 0x85, '_': 0xa0, 'L': 0x2028, 'P': 0x2029,
+}
+
+// unescape decodes the escape sequence at the start of s and returns its
+// length.
+func unescape(s string) (rune, int, bool) {
+	if len(s) < 2 {
+		return 0, 0, false
+	}
+	if r, ok := escapes[s[1]]; ok {
+		return r, 2, true
+	}
+	digits := map[byte]int{'x': 2, 'u': 4, 'U': 8}[s[1]]
+	if digits == 0 || len(s) < 2+digits {
+		return 0, 0, false
+	}
+	code, err := strconv.ParseUint(s[2:2+digits], 16, 32)
+	if err != nil {
+		return 0, 0, false
+	}
+	return rune(code), 2 + digits, true
+}
+
+// SchemaSource returns the position of the i-th schema entry, or nil if it
+// is unknown.
+func (s SQL) SchemaSource(i int) *Source {
+	if i < len(s.SchemaSources) {
+		return &s.SchemaSources[i]
+	}
+	return nil
+}
+
+// QueriesSource returns the position of the i-th queries entry, or nil if it
+// is unknown.
+func (s SQL) QueriesSource(i int) *Source {
+	if i < len(s.QueriesSources) {
+		return &s.QueriesSources[i]
+	}
+	return nil
+}
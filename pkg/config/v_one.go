@@ -43,6 +43,8 @@ type v1PackageSettings struct {
 	OutputQuerierFileName     string     `json:"output_querier_file_name,omitempty" yaml:"output_querier_file_name"`
 	OutputFilesSuffix         string     `json:"output_files_suffix,omitempty" yaml:"output_files_suffix"`
 	StrictFunctionChecks      bool       `json:"strict_function_checks" yaml:"strict_function_checks"`
+	NullablePrimaryKeys       bool       `json:"nullable_primary_keys,omitempty" yaml:"nullable_primary_keys"`
+	Database                  string     `json:"database,omitempty" yaml:"database"`
 }
 
 func v1ParseConfig(rd io.Reader) (Config, error) {
@@ -146,6 +148,8 @@ func (c *V1GenerateSettings) Translate() Config {
 				},
 			},
 			StrictFunctionChecks: pkg.StrictFunctionChecks,
+			NullablePrimaryKeys:  pkg.NullablePrimaryKeys,
+			Database:             pkg.Database,
 		})
 	}
 
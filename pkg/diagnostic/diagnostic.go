@@ -0,0 +1,156 @@
+// Package diagnostic formats compiler errors for people and for tools.
+//
+// Errors can be written as plain text (file:line:col: message), as JSON
+// lines or as a SARIF 2.1.0 log suitable for code scanning uploads.
+package diagnostic
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/stephenwithav/sqlc/pkg/multierr"
+	"github.com/stephenwithav/sqlc/pkg/sql/sqlerr"
+)
+
+type Severity string
+
+const (
+	SeverityError   Severity = "error"
+	SeverityWarning Severity = "warning"
+	SeverityNote    Severity = "note"
+)
+
+// DefaultRule identifies errors which carry no more specific code.
+const DefaultRule = "sqlc"
+
+// A Diagnostic is a single problem. Lines and columns are 1-based; the end
+// column is exclusive. A zero Line means the problem has no known position.
+type Diagnostic struct {
+	Filename  string   `json:"file,omitempty"`
+	Line      int      `json:"line,omitempty"`
+	Column    int      `json:"column,omitempty"`
+	EndLine   int      `json:"end_line,omitempty"`
+	EndColumn int      `json:"end_column,omitempty"`
+	Severity  Severity `json:"severity"`
+	Rule      string   `json:"rule"`
+	Message   string   `json:"message"`
+}
+
+type Format string
+
+const (
+	FormatText  Format = "text"
+	FormatJSON  Format = "json"
+	FormatSARIF Format = "sarif"
+)
+
+func ParseFormat(s string) (Format, error) {
+	switch f := Format(s); f {
+	case "":
+		return FormatText, nil
+	case FormatText, FormatJSON, FormatSARIF:
+		return f, nil
+	default:
+		return "", fmt.Errorf("unknown diagnostics format %q: must be one of text, json or sarif", s)
+	}
+}
+
+// Rule returns the rule ID for an error: the SQLSTATE code of a
+// *sqlerr.Error, or DefaultRule.
+func Rule(err error) string {
+	var serr *sqlerr.Error
+	if errors.As(err, &serr) && serr.Code != "" {
+		return serr.Code
+	}
+	return DefaultRule
+}
+
+// FromError converts an error returned by the compiler into diagnostics. A
+// *multierr.Error is flattened into one diagnostic per file error. Errors
+// which name no file are reported against filename, the configuration file.
+// When src holds the contents of that file, each region in it is extended to
+// the end of the token it starts at.
+func FromError(err error, filename, src string) []Diagnostic {
+	var merr *multierr.Error
+	if !errors.As(err, &merr) {
+		return []Diagnostic{{
+			Filename: filename,
+			Severity: SeverityError,
+			Rule:     Rule(err),
+			Message:  err.Error(),
+		}}
+	}
+	var lines []string
+	if src != "" {
+		lines = strings.Split(src, "\n")
+	}
+	var diags []Diagnostic
+	for _, fileErr := range merr.Errs() {
+		diags = append(diags, fromFileError(fileErr, filename, lines))
+	}
+	return diags
+}
+
+func fromFileError(fileErr *multierr.FileError, filename string, lines []string) Diagnostic {
+	if fileErr.Filename != "" && fileErr.Filename != filename {
+		lines = nil
+	}
+	if fileErr.Filename != "" {
+		filename = fileErr.Filename
+	}
+	d := Diagnostic{
+		Filename:  filename,
+		Line:      fileErr.Line,
+		Column:    fileErr.Column,
+		EndLine:   fileErr.Line,
+		EndColumn: fileErr.Column,
+		Severity:  SeverityError,
+		Rule:      Rule(fileErr.Err),
+		Message:   fileErr.Err.Error(),
+	}
+	if d.Line > 0 && d.Line <= len(lines) && d.Column > 0 {
+		line := lines[d.Line-1]
+		end := d.Column - 1
+		for end < len(line) && line[end] != ' ' && line[end] != '\t' {
+			end++
+		}
+		d.EndColumn = end + 1
+	}
+	return d
+}
+
+// Write formats diagnostics to w.
+func Write(w io.Writer, format Format, diags []Diagnostic) error {
+	switch format {
+	case FormatText, "":
+		for _, d := range diags {
+			if d.Line == 0 {
+				if _, err := fmt.Fprintf(w, "%s: %s\n", d.Filename, d.Message); err != nil {
+					return err
+				}
+				continue
+			}
+			if _, err := fmt.Fprintf(w, "%s:%d:%d: %s\n", d.Filename, d.Line, d.Column, d.Message); err != nil {
+				return err
+			}
+		}
+		return nil
+	case FormatJSON:
+		enc := json.NewEncoder(w)
+		for _, d := range diags {
+			if err := enc.Encode(d); err != nil {
+				return err
+			}
+		}
+		return nil
+	case FormatSARIF:
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(newSARIF(diags))
+	default:
+		return fmt.Errorf("unknown diagnostics format %q", format)
+	}
+}
@@ -0,0 +1,134 @@
+package diagnostic
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/stephenwithav/sqlc/pkg/multierr"
+	"github.com/stephenwithav/sqlc/pkg/sql/sqlerr"
+)
+
+const src = `version: "2"
+sql:
+  - engine: postgresql
+    queries: |
+      SELECT foo FROM authors;
+`
+
+func testError() error {
+	merr := multierr.New()
+	merr.Add("sqlc.yaml", "", 0, &sqlerr.Error{
+		Code:    "42703",
+		Message: `column "foo" does not exist`,
+		Line:    5,
+		Column:  14,
+	})
+	merr.Add("sqlc.yaml", "", 0, &sqlerr.Error{
+		Message: "syntax error at end of input",
+		Line:    5,
+		Column:  7,
+	})
+	return merr
+}
+
+func TestFromError(t *testing.T) {
+	want := []Diagnostic{
+		{
+			Filename:  "sqlc.yaml",
+			Line:      5,
+			Column:    14,
+			EndLine:   5,
+			EndColumn: 17,
+			Severity:  SeverityError,
+			Rule:      "42703",
+			Message:   `column "foo" does not exist`,
+		},
+		{
+			Filename:  "sqlc.yaml",
+			Line:      5,
+			Column:    7,
+			EndLine:   5,
+			EndColumn: 13,
+			Severity:  SeverityError,
+			Rule:      DefaultRule,
+			Message:   "syntax error at end of input",
+		},
+	}
+	if diff := cmp.Diff(want, FromError(testError(), "sqlc.yaml", src)); diff != "" {
+		t.Errorf("diagnostics differ (-want +got):\n%s", diff)
+	}
+}
+
+func TestWrite(t *testing.T) {
+	diags := FromError(testError(), "sqlc.yaml", src)
+	for _, tc := range []struct {
+		format Format
+		want   string
+	}{
+		{
+			FormatText,
+			"sqlc.yaml:5:14: column \"foo\" does not exist\n" +
+				"sqlc.yaml:5:7: syntax error at end of input\n",
+		},
+		{
+			FormatJSON,
+			`{"file":"sqlc.yaml","line":5,"column":14,"end_line":5,"end_column":17,"severity":"error","rule":"42703","message":"column \"foo\" does not exist"}` + "\n" +
+				`{"file":"sqlc.yaml","line":5,"column":7,"end_line":5,"end_column":13,"severity":"error","rule":"sqlc","message":"syntax error at end of input"}` + "\n",
+		},
+	} {
+		var buf bytes.Buffer
+		if err := Write(&buf, tc.format, diags); err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(tc.want, buf.String()); diff != "" {
+			t.Errorf("%s output differs (-want +got):\n%s", tc.format, diff)
+		}
+	}
+}
+
+func TestWithoutFile(t *testing.T) {
+	var buf bytes.Buffer
+	if err := Write(&buf, FormatText, FromError(errors.New("no queries"), "db/sqlc.json", "")); err != nil {
+		t.Fatal(err)
+	}
+	if want := "db/sqlc.json: no queries\n"; buf.String() != want {
+		t.Errorf("got %q; want %q", buf.String(), want)
+	}
+}
+
+func TestSARIF(t *testing.T) {
+	var buf bytes.Buffer
+	if err := Write(&buf, FormatSARIF, FromError(testError(), "sqlc.yaml", src)); err != nil {
+		t.Fatal(err)
+	}
+	var log sarifLog
+	if err := json.Unmarshal(buf.Bytes(), &log); err != nil {
+		t.Fatal(err)
+	}
+	if log.Version != "2.1.0" || len(log.Runs) != 1 {
+		t.Fatalf("unexpected log: %s", buf.String())
+	}
+	run := log.Runs[0]
+	if diff := cmp.Diff([]sarifRule{{ID: "42703"}, {ID: "sqlc"}}, run.Tool.Driver.Rules); diff != "" {
+		t.Errorf("rules differ (-want +got):\n%s", diff)
+	}
+	want := sarifResult{
+		RuleID:    "42703",
+		RuleIndex: 0,
+		Level:     "error",
+		Message:   sarifMessage{Text: `column "foo" does not exist`},
+		Locations: []sarifLocation{{
+			PhysicalLocation: sarifPhysicalLocation{
+				ArtifactLocation: sarifArtifactLocation{URI: "sqlc.yaml"},
+				Region:           &sarifRegion{StartLine: 5, StartColumn: 14, EndLine: 5, EndColumn: 17},
+			},
+		}},
+	}
+	if diff := cmp.Diff(want, run.Results[0]); diff != "" {
+		t.Errorf("result differs (-want +got):\n%s", diff)
+	}
+}
@@ -91,9 +91,9 @@ const getMaxInt = `-- name: GetMaxInt :one
 SELECT max(int_val) FROM test
 `
 
-func (q *Queries) GetMaxInt(ctx context.Context) (interface{}, error) {
+func (q *Queries) GetMaxInt(ctx context.Context) (sql.NullInt64, error) {
 	row := q.db.QueryRowContext(ctx, getMaxInt)
-	var max interface{}
+	var max sql.NullInt64
 	err := row.Scan(&max)
 	return max, err
 }
@@ -102,9 +102,9 @@ const getMaxText = `-- name: GetMaxText :one
 SELECT max(text_val) FROM test
 `
 
-func (q *Queries) GetMaxText(ctx context.Context) (interface{}, error) {
+func (q *Queries) GetMaxText(ctx context.Context) (sql.NullString, error) {
 	row := q.db.QueryRowContext(ctx, getMaxText)
-	var max interface{}
+	var max sql.NullString
 	err := row.Scan(&max)
 	return max, err
 }
@@ -113,9 +113,9 @@ const getMinInt = `-- name: GetMinInt :one
 SELECT min(int_val) FROM test
 `
 
-func (q *Queries) GetMinInt(ctx context.Context) (interface{}, error) {
+func (q *Queries) GetMinInt(ctx context.Context) (sql.NullInt64, error) {
 	row := q.db.QueryRowContext(ctx, getMinInt)
-	var min interface{}
+	var min sql.NullInt64
 	err := row.Scan(&min)
 	return min, err
 }
@@ -124,9 +124,9 @@ const getMinText = `-- name: GetMinText :one
 SELECT min(text_val) FROM test
 `
 
-func (q *Queries) GetMinText(ctx context.Context) (interface{}, error) {
+func (q *Queries) GetMinText(ctx context.Context) (sql.NullString, error) {
 	row := q.db.QueryRowContext(ctx, getMinText)
-	var min interface{}
+	var min sql.NullString
 	err := row.Scan(&min)
 	return min, err
 }
@@ -135,9 +135,9 @@ const getSumInt = `-- name: GetSumInt :one
 SELECT sum(int_val) FROM test
 `
 
-func (q *Queries) GetSumInt(ctx context.Context) (sql.NullFloat64, error) {
+func (q *Queries) GetSumInt(ctx context.Context) (sql.NullInt64, error) {
 	row := q.db.QueryRowContext(ctx, getSumInt)
-	var sum sql.NullFloat64
+	var sum sql.NullInt64
 	err := row.Scan(&sum)
 	return sum, err
 }
@@ -14,9 +14,9 @@ const getAbs = `-- name: GetAbs :one
 SELECT abs(int_val) FROM test
 `
 
-func (q *Queries) GetAbs(ctx context.Context) (float64, error) {
+func (q *Queries) GetAbs(ctx context.Context) (int64, error) {
 	row := q.db.QueryRowContext(ctx, getAbs)
-	var abs float64
+	var abs int64
 	err := row.Scan(&abs)
 	return abs, err
 }
@@ -58,9 +58,9 @@ const getCoalesce = `-- name: GetCoalesce :one
 SELECT coalesce(NULL, 1, 'test')
 `
 
-func (q *Queries) GetCoalesce(ctx context.Context) (interface{}, error) {
+func (q *Queries) GetCoalesce(ctx context.Context) (int64, error) {
 	row := q.db.QueryRowContext(ctx, getCoalesce)
-	var coalesce interface{}
+	var coalesce int64
 	err := row.Scan(&coalesce)
 	return coalesce, err
 }
@@ -234,9 +234,9 @@ const getMax3 = `-- name: GetMax3 :one
 SELECT max(1, 3, 2)
 `
 
-func (q *Queries) GetMax3(ctx context.Context) (interface{}, error) {
+func (q *Queries) GetMax3(ctx context.Context) (sql.NullInt64, error) {
 	row := q.db.QueryRowContext(ctx, getMax3)
-	var max interface{}
+	var max sql.NullInt64
 	err := row.Scan(&max)
 	return max, err
 }
@@ -245,9 +245,9 @@ const getMin3 = `-- name: GetMin3 :one
 SELECT min(1, 3, 2)
 `
 
-func (q *Queries) GetMin3(ctx context.Context) (interface{}, error) {
+func (q *Queries) GetMin3(ctx context.Context) (sql.NullInt64, error) {
 	row := q.db.QueryRowContext(ctx, getMin3)
-	var min interface{}
+	var min sql.NullInt64
 	err := row.Scan(&min)
 	return min, err
 }
@@ -289,9 +289,9 @@ const getRandom = `-- name: GetRandom :one
 SELECT random()
 `
 
-func (q *Queries) GetRandom(ctx context.Context) (interface{}, error) {
+func (q *Queries) GetRandom(ctx context.Context) (int64, error) {
 	row := q.db.QueryRowContext(ctx, getRandom)
-	var random interface{}
+	var random int64
 	err := row.Scan(&random)
 	return random, err
 }
@@ -300,9 +300,9 @@ const getRandomBlob = `-- name: GetRandomBlob :one
 SELECT randomblob(16)
 `
 
-func (q *Queries) GetRandomBlob(ctx context.Context) (interface{}, error) {
+func (q *Queries) GetRandomBlob(ctx context.Context) ([]byte, error) {
 	row := q.db.QueryRowContext(ctx, getRandomBlob)
-	var randomblob interface{}
+	var randomblob []byte
 	err := row.Scan(&randomblob)
 	return randomblob, err
 }
@@ -0,0 +1,17 @@
+-- name: GetDate :one
+SELECT date('now');
+
+-- name: GetTime :one
+SELECT time('now', 'localtime');
+
+-- name: GetDatetime :one
+SELECT datetime(?, 'unixepoch') AS moment;
+
+-- name: GetJulianday :one
+SELECT julianday('now');
+
+-- name: GetUnixepoch :one
+SELECT unixepoch();
+
+-- name: GetStrftime :one
+SELECT strftime('%Y-%m-%d', ?) AS day;
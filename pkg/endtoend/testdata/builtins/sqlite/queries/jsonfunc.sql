@@ -0,0 +1,35 @@
+-- name: GetJson :one
+SELECT json(text_val) FROM test;
+
+-- name: GetJsonArray :one
+SELECT json_array(int_val, text_val) FROM test;
+
+-- name: GetJsonArrayLength :one
+SELECT json_array_length(text_val, '$.a') FROM test;
+
+-- name: GetJsonExtract :one
+SELECT json_extract(text_val, '$.a', '$.b') FROM test;
+
+-- name: GetJsonExtractArrow :one
+SELECT text_val -> '$.a' FROM test;
+
+-- name: GetJsonExtractValueArrow :one
+SELECT text_val ->> '$.a' FROM test;
+
+-- name: GetJsonGroupArray :one
+SELECT json_group_array(int_val) FROM test;
+
+-- name: GetJsonGroupObject :one
+SELECT json_group_object(text_val, int_val) FROM test;
+
+-- name: GetJsonObject :one
+SELECT json_object('id', int_val, 'name', text_val) FROM test;
+
+-- name: GetJsonSet :one
+SELECT json_set(text_val, '$.a', ?) AS doc FROM test;
+
+-- name: GetJsonType :one
+SELECT json_type(text_val) FROM test;
+
+-- name: GetJsonValid :one
+SELECT json_valid(text_val) FROM test;
@@ -0,0 +1,8 @@
+CREATE TABLE orders (
+  id integer PRIMARY KEY,
+  customer_id integer NOT NULL,
+  amount decimal(10, 2) NOT NULL
+);
+
+-- name: CustomerTotals :one
+CALL customer_totals(sqlc.arg(customer_id), @order_count, @total);
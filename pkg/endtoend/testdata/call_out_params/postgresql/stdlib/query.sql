@@ -0,0 +1,16 @@
+CREATE TABLE orders (
+  id integer PRIMARY KEY,
+  customer_id integer NOT NULL,
+  amount numeric NOT NULL
+);
+
+CREATE PROCEDURE customer_totals(IN customer integer, INOUT min_amount numeric, OUT order_count bigint, OUT total numeric)
+LANGUAGE plpgsql AS $$
+BEGIN
+  SELECT count(*), sum(amount) INTO order_count, total
+  FROM orders WHERE customer_id = customer AND amount >= min_amount;
+END
+$$;
+
+-- name: CustomerTotals :one
+CALL customer_totals($1, $2, NULL, NULL);
@@ -0,0 +1,28 @@
+CREATE TABLE categories (
+  id INT NOT NULL PRIMARY KEY,
+  parent_id INT,
+  name TEXT NOT NULL
+);
+
+-- name: CategoryTree :many
+WITH RECURSIVE tree (id, parent, label, depth) AS (
+    SELECT id, parent_id, name, 1 FROM categories
+    WHERE parent_id IS NULL
+  UNION ALL
+    SELECT c.id, c.parent_id, c.name, t.depth + 1
+    FROM categories c
+    JOIN tree t ON c.parent_id = t.id
+)
+SELECT id, parent, label, depth FROM tree
+WHERE depth <= $1;
+
+-- name: CategoryAncestors :many
+WITH RECURSIVE ancestors AS (
+    SELECT id, parent_id, name FROM categories
+    WHERE id = $1
+  UNION ALL
+    SELECT c.id, c.parent_id, c.name
+    FROM categories c, ancestors a
+    WHERE c.id = a.parent_id
+)
+SELECT * FROM ancestors;
@@ -0,0 +1,9 @@
+-- name: GetUser :one
+SELECT * FROM users WHERE email = $1;
+
+-- name: ListUsersByTags :many
+SELECT id, zip FROM users WHERE tags && $1;
+
+-- name: CreateUser :exec
+INSERT INTO users (email, backup_email, work_email, logins, tags, zip)
+VALUES ($1, $2, $3, $4, $5, $6);
@@ -0,0 +1,20 @@
+CREATE EXTENSION IF NOT EXISTS citext;
+
+CREATE DOMAIN email_address AS citext CHECK (VALUE ~ '^[^@]+@[^@]+$');
+CREATE DOMAIN work_email AS email_address NOT NULL;
+CREATE DOMAIN positive_int AS integer NOT NULL DEFAULT 1 CHECK (VALUE > 0);
+CREATE DOMAIN tags AS text[];
+CREATE DOMAIN postal_code AS text CONSTRAINT postal_code_format CHECK (VALUE ~ '^\d{5}$');
+
+ALTER DOMAIN tags SET NOT NULL;
+COMMENT ON DOMAIN email_address IS 'An email address';
+
+CREATE TABLE users (
+  id           serial PRIMARY KEY,
+  email        email_address NOT NULL,
+  backup_email email_address,
+  work_email   work_email,
+  logins       positive_int,
+  tags         tags,
+  zip          postal_code
+);
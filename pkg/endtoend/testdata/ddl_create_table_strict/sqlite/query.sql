@@ -0,0 +1,33 @@
+CREATE TABLE products (
+  id INTEGER PRIMARY KEY,
+  sku VARCHAR(32) NOT NULL,
+  price DOUBLE PRECISION NOT NULL,
+  quantity BIGINT NOT NULL,
+  image MEDIUMBLOB,
+  total REAL GENERATED ALWAYS AS (price * quantity) STORED,
+  label TEXT AS (upper(sku))
+);
+
+CREATE TABLE tags (
+  name TEXT PRIMARY KEY,
+  weight INT,
+  note ANY
+) STRICT;
+
+CREATE TABLE aliases (
+  code TEXT,
+  product_id INT NOT NULL,
+  PRIMARY KEY (code)
+) WITHOUT ROWID, STRICT;
+
+-- name: GetProduct :one
+SELECT * FROM products WHERE id = ?;
+
+-- name: CreateProduct :exec
+INSERT INTO products (sku, price, quantity) VALUES (?, ?, ?);
+
+-- name: ListTags :many
+SELECT name, weight, note FROM tags WHERE weight > ?;
+
+-- name: GetAlias :one
+SELECT * FROM aliases WHERE code = ?;
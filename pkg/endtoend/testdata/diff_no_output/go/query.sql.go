@@ -75,9 +75,9 @@ const selectOne = `-- name: SelectOne :one
 SELECT 1
 `
 
-func (q *Queries) SelectOne(ctx context.Context) (interface{}, error) {
+func (q *Queries) SelectOne(ctx context.Context) (int32, error) {
 	row := q.db.QueryRowContext(ctx, selectOne)
-	var column_1 interface{}
+	var column_1 int32
 	err := row.Scan(&column_1)
 	return column_1, err
 }
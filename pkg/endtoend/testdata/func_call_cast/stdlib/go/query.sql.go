@@ -15,9 +15,9 @@ const demo = `-- name: Demo :one
 SELECT uuid_generate_v5('7c4597a0-8cfa-4c19-8da0-b8474a36440d', $1)::uuid as col1
 `
 
-func (q *Queries) Demo(ctx context.Context, uuidGenerateV5 interface{}) (uuid.UUID, error) {
+func (q *Queries) Demo(ctx context.Context, uuidGenerateV5 interface{}) (uuid.NullUUID, error) {
 	row := q.db.QueryRowContext(ctx, demo, uuidGenerateV5)
-	var col1 uuid.UUID
+	var col1 uuid.NullUUID
 	err := row.Scan(&col1)
 	return col1, err
 }
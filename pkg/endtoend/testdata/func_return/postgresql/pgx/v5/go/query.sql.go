@@ -21,15 +21,15 @@ type GenerateSeriesParams struct {
 	Column2 int32
 }
 
-func (q *Queries) GenerateSeries(ctx context.Context, arg GenerateSeriesParams) ([]int32, error) {
+func (q *Queries) GenerateSeries(ctx context.Context, arg GenerateSeriesParams) ([]netip.Addr, error) {
 	rows, err := q.db.Query(ctx, generateSeries, arg.Column1, arg.Column2)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []int32
+	var items []netip.Addr
 	for rows.Next() {
-		var column_1 int32
+		var column_1 netip.Addr
 		if err := rows.Scan(&column_1); err != nil {
 			return nil, err
 		}
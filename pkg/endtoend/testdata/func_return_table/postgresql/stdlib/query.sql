@@ -0,0 +1,29 @@
+CREATE TABLE users (
+  id integer PRIMARY KEY,
+  name text NOT NULL,
+  bio text
+);
+
+CREATE FUNCTION search_users(pattern text) RETURNS SETOF users AS $$
+  SELECT * FROM users WHERE name LIKE pattern
+$$ LANGUAGE sql;
+
+CREATE FUNCTION user_stats(min_id integer) RETURNS TABLE (user_id integer, total bigint) AS $$
+  SELECT id, 1::bigint FROM users WHERE id >= min_id
+$$ LANGUAGE sql;
+
+CREATE FUNCTION id_bounds(OUT low integer, OUT high integer) AS $$
+  SELECT min(id), max(id) FROM users
+$$ LANGUAGE sql;
+
+-- name: SearchUsers :many
+SELECT * FROM search_users($1);
+
+-- name: UserStats :many
+SELECT s.user_id, s.total, u.name
+FROM user_stats($1) s
+JOIN users u ON u.id = s.user_id
+WHERE s.total > $2;
+
+-- name: IDBounds :one
+SELECT b.* FROM id_bounds() AS b (lo, hi);
@@ -0,0 +1,26 @@
+CREATE TABLE counters (
+  name text PRIMARY KEY,
+  value integer NOT NULL,
+  updated_at text
+);
+
+-- name: SetCounter :one
+INSERT OR REPLACE INTO counters (name, value) VALUES (?, ?)
+RETURNING name, value AS latest;
+
+-- name: AddCounter :exec
+INSERT OR IGNORE INTO counters (name, value) VALUES (?, ?);
+
+-- name: ReplaceCounter :exec
+REPLACE INTO counters (name, value, updated_at) VALUES (?, ?, ?);
+
+-- name: IncrementCounter :one
+INSERT INTO counters (name, value) VALUES (?, ?)
+ON CONFLICT (name) DO UPDATE SET value = value + excluded.value, updated_at = ?
+WHERE excluded.value > ?
+RETURNING *;
+
+-- name: IncrementCounters :many
+INSERT INTO counters (name, value) VALUES (?, 1), (?, 1)
+ON CONFLICT (name) DO UPDATE SET value = value + 1
+RETURNING name AS counter, value;
@@ -0,0 +1,45 @@
+CREATE TABLE posts (
+  id int PRIMARY KEY,
+  title text NOT NULL,
+  body text,
+  published date NOT NULL,
+  featured bool,
+  price decimal(10, 2) NOT NULL,
+  FULLTEXT (title, body)
+);
+
+-- name: ListDrafts :many
+SELECT id, body IS NULL AS empty
+FROM posts
+WHERE body IS NOT NULL AND featured IS NOT TRUE AND id > ?;
+
+-- name: GetByKey :one
+SELECT id, title FROM posts WHERE (id, title) = (?, ?);
+
+-- name: UpsertTitle :exec
+INSERT INTO posts (id, title, published, price) VALUES (?, ?, ?, ?)
+ON DUPLICATE KEY UPDATE title = VALUES(title);
+
+-- name: ListByPrice :many
+SELECT id, CAST(price AS SIGNED) AS whole, CONVERT(id, CHAR) AS label
+FROM posts
+WHERE price > CAST(sqlc.arg(min_price) AS DECIMAL(10, 2))
+ORDER BY 2 DESC, 1;
+
+-- name: ListTrimmed :many
+SELECT TRIM(LEADING sqlc.arg(prefix) FROM title) AS trimmed, DATE_ADD(published, INTERVAL 7 DAY) AS next_week
+FROM posts
+WHERE TIMESTAMPDIFF(DAY, published, sqlc.arg(since)) < 30;
+
+-- name: Search :many
+SELECT id, MATCH (title, body) AGAINST (? IN NATURAL LANGUAGE MODE) AS score
+FROM posts
+WHERE title REGEXP ? AND body NOT RLIKE ?;
+
+-- name: RunningTotals :many
+SELECT id, SUM(price) OVER (ORDER BY id ROWS BETWEEN 1 PRECEDING AND CURRENT ROW) AS recent
+FROM posts
+WHERE NOT featured;
+
+-- name: SessionMode :one
+SELECT @@sql_mode AS mode;
@@ -44,3 +44,21 @@ func (q *Queries) UpsertAuthorNamed(ctx context.Context, arg UpsertAuthorNamedPa
 	_, err := q.db.ExecContext(ctx, upsertAuthorNamed, arg.Name, arg.Bio, arg.Bio)
 	return err
 }
+
+const upsertAuthorRowAlias = `-- name: UpsertAuthorRowAlias :exec
+INSERT INTO authors (name, bio)
+VALUES (?, ?) AS new
+ON DUPLICATE KEY
+    UPDATE bio = coalesce(new.bio, ?)
+`
+
+type UpsertAuthorRowAliasParams struct {
+	Name  string
+	Bio   sql.NullString
+	Bio_2 sql.NullString
+}
+
+func (q *Queries) UpsertAuthorRowAlias(ctx context.Context, arg UpsertAuthorRowAliasParams) error {
+	_, err := q.db.ExecContext(ctx, upsertAuthorRowAlias, arg.Name, arg.Bio, arg.Bio_2)
+	return err
+}
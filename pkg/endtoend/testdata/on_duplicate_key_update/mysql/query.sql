@@ -17,3 +17,9 @@ INSERT INTO authors (name, bio)
 VALUES (?, sqlc.arg(bio))
 ON DUPLICATE KEY
     UPDATE bio = sqlc.arg(bio);
+
+-- name: UpsertAuthorRowAlias :exec
+INSERT INTO authors (name, bio)
+VALUES (?, ?) AS new
+ON DUPLICATE KEY
+    UPDATE bio = coalesce(new.bio, ?);
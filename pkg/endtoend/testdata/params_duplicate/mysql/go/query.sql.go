@@ -16,7 +16,7 @@ users where (? = id OR ? = 0)
 `
 
 type SelectUserByIDParams struct {
-	ID interface{}
+	ID int32
 }
 
 func (q *Queries) SelectUserByID(ctx context.Context, arg SelectUserByIDParams) ([]sql.NullString, error) {
@@ -82,12 +82,12 @@ users where (? = id OR  ? = 0)
 `
 
 type SelectUserQuestionParams struct {
-	Column1 interface{}
-	Column2 interface{}
+	ID      int32
+	Column2 int32
 }
 
 func (q *Queries) SelectUserQuestion(ctx context.Context, arg SelectUserQuestionParams) ([]sql.NullString, error) {
-	rows, err := q.db.QueryContext(ctx, selectUserQuestion, arg.Column1, arg.Column2)
+	rows, err := q.db.QueryContext(ctx, selectUserQuestion, arg.ID, arg.Column2)
 	if err != nil {
 		return nil, err
 	}
@@ -15,7 +15,7 @@ SELECT first_name from
 users where ($1 = id OR $1 = 0)
 `
 
-func (q *Queries) SelectUserByID(ctx context.Context, id interface{}) ([]sql.NullString, error) {
+func (q *Queries) SelectUserByID(ctx context.Context, id int32) ([]sql.NullString, error) {
 	rows, err := q.db.QueryContext(ctx, selectUserByID, id)
 	if err != nil {
 		return nil, err
@@ -73,8 +73,8 @@ SELECT first_name from
 users where ($1 = id OR  $1 = 0)
 `
 
-func (q *Queries) SelectUserQuestion(ctx context.Context, dollar_1 interface{}) ([]sql.NullString, error) {
-	rows, err := q.db.QueryContext(ctx, selectUserQuestion, dollar_1)
+func (q *Queries) SelectUserQuestion(ctx context.Context, id int32) ([]sql.NullString, error) {
+	rows, err := q.db.QueryContext(ctx, selectUserQuestion, id)
 	if err != nil {
 		return nil, err
 	}
@@ -16,8 +16,8 @@ SELECT table_name::text, column_name::text from information_schema.columns
 `
 
 type GetColumnsRow struct {
-	TableName  string
-	ColumnName string
+	TableName  pgtype.Text
+	ColumnName pgtype.Text
 }
 
 func (q *Queries) GetColumns(ctx context.Context) ([]GetColumnsRow, error) {
@@ -44,15 +44,15 @@ const getTables = `-- name: GetTables :many
 SELECT table_name::text from information_schema.tables
 `
 
-func (q *Queries) GetTables(ctx context.Context) ([]string, error) {
+func (q *Queries) GetTables(ctx context.Context) ([]pgtype.Text, error) {
 	rows, err := q.db.Query(ctx, getTables)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []string
+	var items []pgtype.Text
 	for rows.Next() {
-		var table_name string
+		var table_name pgtype.Text
 		if err := rows.Scan(&table_name); err != nil {
 			return nil, err
 		}
@@ -15,8 +15,8 @@ SELECT table_name::text, column_name::text from information_schema.columns
 `
 
 type GetColumnsRow struct {
-	TableName  string
-	ColumnName string
+	TableName  sql.NullString
+	ColumnName sql.NullString
 }
 
 func (q *Queries) GetColumns(ctx context.Context) ([]GetColumnsRow, error) {
@@ -46,15 +46,15 @@ const getTables = `-- name: GetTables :many
 SELECT table_name::text from information_schema.tables
 `
 
-func (q *Queries) GetTables(ctx context.Context) ([]string, error) {
+func (q *Queries) GetTables(ctx context.Context) ([]sql.NullString, error) {
 	rows, err := q.db.QueryContext(ctx, getTables)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []string
+	var items []sql.NullString
 	for rows.Next() {
-		var table_name string
+		var table_name sql.NullString
 		if err := rows.Scan(&table_name); err != nil {
 			return nil, err
 		}
@@ -0,0 +1,12 @@
+CREATE TABLE posts (
+  id    int NOT NULL,
+  title varchar(100) COLLATE utf8mb4_bin NOT NULL,
+  tags  SET('go', 'sql', 'data-science') NOT NULL,
+  flags SET('draft', 'pinned')
+);
+
+-- name: GetPost :one
+SELECT id, title, tags, flags FROM posts WHERE id = ?;
+
+-- name: CreatePost :exec
+INSERT INTO posts (id, title, tags, flags) VALUES (?, ?, ?, ?);
@@ -0,0 +1,13 @@
+CREATE TABLE foo (name text not null, bio text);
+
+-- name: FuncParamIdent :many
+SELECT name FROM foo WHERE name = sqlc.arg(slug);
+
+-- name: FuncParamString :many
+SELECT name FROM foo WHERE name = sqlc.arg('slug');
+
+-- name: FuncParamRepeated :many
+SELECT name FROM foo WHERE name = sqlc.arg(slug) OR bio = sqlc.arg(slug);
+
+-- name: FuncNullableParam :many
+SELECT name FROM foo WHERE bio = sqlc.narg(bio);
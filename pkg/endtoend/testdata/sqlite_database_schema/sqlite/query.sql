@@ -0,0 +1,9 @@
+-- name: ListAuthorTags :many
+SELECT authors.name AS author, tags.name, tags.weight
+FROM tags
+JOIN authors ON authors.id = tags.author_id
+WHERE tags.author_id = ?
+ORDER BY tags.name;
+
+-- name: TagAuthor :exec
+INSERT INTO tags (author_id, name, weight) VALUES (?, ?, ?);
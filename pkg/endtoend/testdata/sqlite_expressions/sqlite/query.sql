@@ -0,0 +1,48 @@
+CREATE TABLE posts (
+  id integer PRIMARY KEY,
+  title text NOT NULL,
+  body text,
+  author_id integer NOT NULL,
+  score real NOT NULL
+);
+
+CREATE TABLE authors (
+  id integer PRIMARY KEY,
+  name text NOT NULL
+);
+
+-- name: ListDrafts :many
+SELECT id, body IS NULL AS empty, CAST(score AS integer) AS rounded
+FROM posts
+WHERE body NOTNULL AND (title NOT LIKE ?) AND id > ?;
+
+-- name: SearchTitles :many
+SELECT id, title COLLATE NOCASE AS title
+FROM posts
+WHERE title GLOB ? OR title LIKE ? ESCAPE '\'
+ORDER BY title COLLATE NOCASE;
+
+-- name: ListByAuthors :many
+SELECT id, title FROM posts
+WHERE author_id IN (?, ?) AND id NOT IN (SELECT id FROM posts WHERE score < ?);
+
+-- name: HasPosts :one
+SELECT EXISTS (SELECT 1 FROM posts WHERE author_id = ?) AS found;
+
+-- name: ListScored :many
+SELECT id, score * 2 + 1 AS doubled, -score AS negated, 'post:' || title AS label
+FROM posts
+WHERE score >= ? AND score <> ?;
+
+-- name: ListAuthorsAndTitles :many
+SELECT name FROM authors WHERE id = ?
+UNION
+SELECT title FROM posts WHERE author_id = ?;
+
+-- name: DeleteOldPosts :exec
+WITH old AS (SELECT id FROM posts WHERE score < ?)
+DELETE FROM posts WHERE id IN (SELECT id FROM old);
+
+-- name: UpdateScores :exec
+WITH top AS (SELECT id FROM posts WHERE score > ?)
+UPDATE posts SET score = ? WHERE id IN (SELECT id FROM top);
@@ -0,0 +1,23 @@
+CREATE TABLE authors (
+  id integer PRIMARY KEY,
+  name text NOT NULL
+);
+
+CREATE TABLE tags (
+  slug text PRIMARY KEY,
+  label text NOT NULL
+);
+
+CREATE TABLE settings (
+  key text PRIMARY KEY,
+  value text
+) WITHOUT ROWID;
+
+-- name: GetAuthor :one
+SELECT * FROM authors WHERE id = ?;
+
+-- name: GetTag :one
+SELECT * FROM tags WHERE slug = ?;
+
+-- name: GetSetting :one
+SELECT * FROM settings WHERE key = ?;
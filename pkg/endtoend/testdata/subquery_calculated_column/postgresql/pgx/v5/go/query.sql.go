@@ -7,21 +7,23 @@ package querytest
 
 import (
 	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 const subqueryCalcColumn = `-- name: SubqueryCalcColumn :many
 SELECT sum FROM (SELECT a + b AS sum FROM foo) AS f
 `
 
-func (q *Queries) SubqueryCalcColumn(ctx context.Context) ([]int32, error) {
+func (q *Queries) SubqueryCalcColumn(ctx context.Context) ([]pgtype.Int4, error) {
 	rows, err := q.db.Query(ctx, subqueryCalcColumn)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []int32
+	var items []pgtype.Int4
 	for rows.Next() {
-		var sum int32
+		var sum pgtype.Int4
 		if err := rows.Scan(&sum); err != nil {
 			return nil, err
 		}
@@ -32,7 +32,7 @@ type GetTransactionParams struct {
 
 type GetTransactionRow struct {
 	JsonExtract    interface{}
-	JsonGroupArray interface{}
+	JsonGroupArray string
 }
 
 func (q *Queries) GetTransaction(ctx context.Context, arg GetTransactionParams) ([]GetTransactionRow, error) {
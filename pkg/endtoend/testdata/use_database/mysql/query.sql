@@ -0,0 +1,24 @@
+CREATE DATABASE billing;
+CREATE TABLE billing.accounts (
+  id   int  NOT NULL,
+  name text NOT NULL
+);
+
+CREATE DATABASE app;
+USE app;
+CREATE TABLE users (
+  id         int NOT NULL,
+  account_id int NOT NULL
+);
+
+-- name: ListUserAccounts :many
+SELECT users.id, billing.accounts.name
+FROM users
+JOIN billing.accounts ON billing.accounts.id = users.account_id
+WHERE app.users.account_id = ?;
+
+-- name: ListColumns :many
+SELECT column_name, data_type
+FROM information_schema.columns
+WHERE table_schema = ? AND table_name = ?
+ORDER BY ordinal_position;
@@ -0,0 +1,23 @@
+CREATE TABLE notes (id INTEGER PRIMARY KEY, title TEXT NOT NULL, body TEXT NOT NULL);
+
+CREATE VIRTUAL TABLE docs USING fts5(title, body UNINDEXED, tokenize = 'porter');
+
+CREATE VIRTUAL TABLE boxes USING rtree(id, min_x, max_x, min_y, max_y, +label);
+
+-- name: SearchDocs :many
+SELECT rowid, title, highlight(docs, 0, '<b>', '</b>') AS snippet
+FROM docs
+WHERE docs MATCH ?
+ORDER BY rank;
+
+-- name: ListDocs :many
+SELECT * FROM docs;
+
+-- name: ListBoxes :many
+SELECT * FROM boxes WHERE min_x >= ? AND max_x <= ?;
+
+-- name: ListTags :many
+SELECT key, value FROM json_each(?);
+
+-- name: ListNoteColumns :many
+SELECT name, type FROM pragma_table_info('notes');
@@ -4,13 +4,24 @@ import (
 	"github.com/stephenwithav/sqlc/pkg/sql/catalog"
 )
 
-func NewCatalog() *catalog.Catalog {
-	def := "public" // TODO: What is the default database for MySQL?
+// NewCatalog returns a catalog whose default schema is the database which
+// queries run against. It is named "public" unless a database is given.
+func NewCatalog(database string) *catalog.Catalog {
+	def := "public"
+	if database != "" {
+		def = identifier(database)
+	}
+	s := defaultSchema(def)
+	s.Operators = builtinOperators()
 	return &catalog.Catalog{
 		DefaultSchema: def,
 		Schemas: []*catalog.Schema{
-			defaultSchema(def),
+			s,
+			informationSchema(),
 		},
+		// The built-in functions stay visible when a USE statement
+		// changes the default schema
+		SearchPath: []string{def},
 		Extensions: map[string]struct{}{},
 	}
 }
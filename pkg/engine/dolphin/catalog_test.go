@@ -0,0 +1,187 @@
+package dolphin
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stephenwithav/sqlc/pkg/sql/ast"
+	"github.com/stephenwithav/sqlc/pkg/sql/catalog"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func TestUpdateIndexes(t *testing.T) {
+	p := NewParser()
+
+	for i, tc := range []struct {
+		stmt      string
+		indexes   []*catalog.Index
+		partition *catalog.Partition
+		sequences []string
+	}{
+		{
+			`
+			CREATE TABLE foo (
+				id int PRIMARY KEY,
+				email varchar(255) UNIQUE,
+				name text,
+				KEY name_idx (name(10)),
+				FOREIGN KEY (id) REFERENCES bar (id)
+			);
+			`,
+			[]*catalog.Index{
+				{Name: "primary", Columns: []string{"id"}, Unique: true, Primary: true},
+				{Name: "foo_email_key", Columns: []string{"email"}, Unique: true},
+				{Name: "name_idx", Columns: []string{"name"}},
+			},
+			nil,
+			nil,
+		},
+		{
+			`
+			CREATE TABLE foo (id int, a int, b int);
+			CREATE UNIQUE INDEX a_b ON foo (a, b DESC);
+			CREATE INDEX b ON foo (b);
+			DROP INDEX b ON foo;
+			`,
+			[]*catalog.Index{
+				{Name: "a_b", Columns: []string{"a", "b"}, Unique: true},
+			},
+			nil,
+			nil,
+		},
+		{
+			`
+			CREATE TABLE foo (id int NOT NULL, a int);
+			ALTER TABLE foo ADD PRIMARY KEY (id), ADD INDEX (a);
+			ALTER TABLE foo DROP PRIMARY KEY;
+			ALTER TABLE foo RENAME COLUMN a TO c;
+			`,
+			[]*catalog.Index{
+				{Name: "foo_a_idx", Columns: []string{"c"}},
+			},
+			nil,
+			nil,
+		},
+		{
+			`
+			CREATE TABLE foo (id int, created date)
+			PARTITION BY RANGE (YEAR(created)) (
+				PARTITION p0 VALUES LESS THAN (2000),
+				PARTITION p1 VALUES LESS THAN MAXVALUE
+			);
+			`,
+			nil,
+			&catalog.Partition{Strategy: "range", Columns: []string{"created"}},
+			nil,
+		},
+		{
+			`
+			CREATE TABLE foo (id int, region varchar(10))
+			PARTITION BY LIST COLUMNS (region) (
+				PARTITION east VALUES IN ('east')
+			);
+			CREATE SEQUENCE foo_seq;
+			CREATE SEQUENCE bar_seq;
+			DROP SEQUENCE bar_seq;
+			`,
+			nil,
+			&catalog.Partition{Strategy: "list", Columns: []string{"region"}},
+			[]string{"foo_seq"},
+		},
+	} {
+		test := tc
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			stmts, err := p.Parse(strings.NewReader(test.stmt))
+			if err != nil {
+				t.Log(test.stmt)
+				t.Fatal(err)
+			}
+
+			c := NewCatalog("")
+			if err := c.Build(stmts); err != nil {
+				t.Log(test.stmt)
+				t.Fatal(err)
+			}
+
+			table, err := c.GetTable(&ast.TableName{Name: "foo"})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(test.indexes, table.Indexes, cmpopts.EquateEmpty()); diff != "" {
+				t.Log(test.stmt)
+				t.Errorf("indexes mismatch:\n%s", diff)
+			}
+			if diff := cmp.Diff(test.partition, table.Partition); diff != "" {
+				t.Log(test.stmt)
+				t.Errorf("partition mismatch:\n%s", diff)
+			}
+			var sequences []string
+			for _, seq := range c.Schemas[0].Sequences {
+				sequences = append(sequences, seq.Rel.Name)
+			}
+			if diff := cmp.Diff(test.sequences, sequences, cmpopts.EquateEmpty()); diff != "" {
+				t.Log(test.stmt)
+				t.Errorf("sequences mismatch:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdateColumns(t *testing.T) {
+	p := NewParser()
+	stmts, err := p.Parse(strings.NewReader(`
+		CREATE TABLE foo (
+			a varchar(10) COLLATE utf8mb4_bin,
+			b text,
+			c int,
+			d SET('x', 'y') NOT NULL,
+			e ENUM('x', 'y') NOT NULL
+		) COLLATE utf8mb4_general_ci;
+		ALTER TABLE foo ADD COLUMN f char(1) CHARACTER SET latin1 COLLATE latin1_swedish_ci;
+	`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := NewCatalog("")
+	if err := c.Build(stmts); err != nil {
+		t.Fatal(err)
+	}
+	table, err := c.GetTable(&ast.TableName{Name: "foo"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	type column struct {
+		Name      string
+		Type      string
+		Collation string
+	}
+	var got []column
+	for _, col := range table.Columns {
+		got = append(got, column{col.Name, col.Type.Name, col.Collation})
+	}
+	want := []column{
+		{"a", "varchar", "utf8mb4_bin"},
+		{"b", "text", "utf8mb4_general_ci"},
+		{"c", "int", ""},
+		{"d", "set", "utf8mb4_general_ci"},
+		{"e", "foo_e", "utf8mb4_general_ci"},
+		{"f", "char", "latin1_swedish_ci"},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("columns mismatch:\n%s", diff)
+	}
+
+	var enums []string
+	for _, typ := range c.Schemas[0].Types {
+		if enum, ok := typ.(*catalog.Enum); ok {
+			enums = append(enums, enum.Name+"("+strings.Join(enum.Vals, ",")+")")
+		}
+	}
+	if diff := cmp.Diff([]string{"foo_d(x,y)", "foo_e(x,y)"}, enums); diff != "" {
+		t.Errorf("enums mismatch:\n%s", diff)
+	}
+}
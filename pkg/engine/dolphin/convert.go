@@ -3,9 +3,11 @@ package dolphin
 import (
 	"fmt"
 	"log"
+	"strconv"
 	"strings"
 
 	pcast "github.com/pingcap/tidb/parser/ast"
+	"github.com/pingcap/tidb/parser/mysql"
 	"github.com/pingcap/tidb/parser/opcode"
 	driver "github.com/pingcap/tidb/parser/test_driver"
 	"github.com/pingcap/tidb/parser/types"
@@ -16,6 +18,7 @@ import (
 
 type cc struct {
 	paramCount int
+	rowAlias   *ast.Alias
 }
 
 func todo(n pcast.Node) *ast.TODO {
@@ -38,15 +41,18 @@ func (c *cc) convertAlterTableStmt(n *pcast.AlterTableStmt) ast.Node {
 		Table: parseTableName(n.Table),
 		Cmds:  &ast.List{},
 	}
+	// Index changes are catalog statements of their own
+	var indexStmts []ast.Node
 	for _, spec := range n.Specs {
 		switch spec.Tp {
 		case pcast.AlterTableAddColumns:
 			for _, def := range spec.NewColumns {
 				name := def.Name.String()
 				columnDef := ast.ColumnDef{
-					Colname:   def.Name.String(),
-					TypeName:  &ast.TypeName{Name: types.TypeToStr(def.Tp.GetType(), def.Tp.GetCharset())},
-					IsNotNull: isNotNull(def),
+					Colname:    def.Name.String(),
+					TypeName:   &ast.TypeName{Name: types.TypeToStr(def.Tp.GetType(), def.Tp.GetCharset())},
+					IsNotNull:  isNotNull(def),
+					CollClause: columnCollation(def, ""),
 				}
 				if def.Tp.GetFlen() >= 0 {
 					length := def.Tp.GetFlen()
@@ -77,9 +83,10 @@ func (c *cc) convertAlterTableStmt(n *pcast.AlterTableStmt) ast.Node {
 			for _, def := range spec.NewColumns {
 				name := def.Name.String()
 				columnDef := ast.ColumnDef{
-					Colname:   def.Name.String(),
-					TypeName:  &ast.TypeName{Name: types.TypeToStr(def.Tp.GetType(), def.Tp.GetCharset())},
-					IsNotNull: isNotNull(def),
+					Colname:    def.Name.String(),
+					TypeName:   &ast.TypeName{Name: types.TypeToStr(def.Tp.GetType(), def.Tp.GetCharset())},
+					IsNotNull:  isNotNull(def),
+					CollClause: columnCollation(def, ""),
 				}
 				if def.Tp.GetFlen() >= 0 {
 					length := def.Tp.GetFlen()
@@ -96,9 +103,10 @@ func (c *cc) convertAlterTableStmt(n *pcast.AlterTableStmt) ast.Node {
 			for _, def := range spec.NewColumns {
 				name := def.Name.String()
 				columnDef := ast.ColumnDef{
-					Colname:   def.Name.String(),
-					TypeName:  &ast.TypeName{Name: types.TypeToStr(def.Tp.GetType(), def.Tp.GetCharset())},
-					IsNotNull: isNotNull(def),
+					Colname:    def.Name.String(),
+					TypeName:   &ast.TypeName{Name: types.TypeToStr(def.Tp.GetType(), def.Tp.GetCharset())},
+					IsNotNull:  isNotNull(def),
+					CollClause: columnCollation(def, ""),
 				}
 				if def.Tp.GetFlen() >= 0 {
 					length := def.Tp.GetFlen()
@@ -119,7 +127,23 @@ func (c *cc) convertAlterTableStmt(n *pcast.AlterTableStmt) ast.Node {
 			// 	spew.Dump("alter column", spec)
 
 		case pcast.AlterTableAddConstraint:
-			// 	spew.Dump("add const", spec)
+			if index, ok := c.convertConstraint(spec.Constraint).(*ast.IndexStmt); ok {
+				index.Relation = c.convertTableName(n.Table)
+				indexStmts = append(indexStmts, index)
+			}
+
+		case pcast.AlterTableDropIndex:
+			indexStmts = append(indexStmts, &ast.DropIndexStmt{
+				IfExists: spec.IfExists,
+				Indexes:  []*ast.TableName{{Name: identifier(spec.Name)}},
+				Table:    parseTableName(n.Table),
+			})
+
+		case pcast.AlterTableDropPrimaryKey:
+			indexStmts = append(indexStmts, &ast.DropIndexStmt{
+				Indexes: []*ast.TableName{{Name: "primary"}},
+				Table:   parseTableName(n.Table),
+			})
 
 		case pcast.AlterTableRenameColumn:
 			// TODO: Returning here may be incorrect if there are multiple specs
@@ -145,6 +169,9 @@ func (c *cc) convertAlterTableStmt(n *pcast.AlterTableStmt) ast.Node {
 			continue
 		}
 	}
+	if len(indexStmts) > 0 {
+		return &ast.List{Items: append([]ast.Node{alt}, indexStmts...)}
+	}
 	return alt
 }
 
@@ -214,8 +241,12 @@ func opToName(o opcode.Op) string {
 
 func (c *cc) convertBinaryOperationExpr(n *pcast.BinaryOperationExpr) ast.Node {
 	if n.Op == opcode.LogicAnd || n.Op == opcode.LogicOr {
+		op := ast.BoolExprTypeAnd
+		if n.Op == opcode.LogicOr {
+			op = ast.BoolExprTypeOr
+		}
 		return &ast.BoolExpr{
-			// TODO: Set op
+			Boolop: op,
 			Args: &ast.List{
 				Items: []ast.Node{
 					c.convert(n.L),
@@ -225,7 +256,7 @@ func (c *cc) convertBinaryOperationExpr(n *pcast.BinaryOperationExpr) ast.Node {
 		}
 	} else {
 		return &ast.A_Expr{
-			// TODO: Set kind
+			Kind: ast.A_Expr_Kind_OP,
 			Name: &ast.List{
 				Items: []ast.Node{
 					&ast.String{Str: opToName(n.Op)},
@@ -245,6 +276,12 @@ func (c *cc) convertCreateTableStmt(n *pcast.CreateTableStmt) ast.Node {
 	if n.ReferTable != nil {
 		create.ReferTable = parseTableName(n.ReferTable)
 	}
+	var tableCollation string
+	for _, opt := range n.Options {
+		if opt.Tp == pcast.TableOptionCollate {
+			tableCollation = opt.StrValue
+		}
+	}
 	for _, def := range n.Cols {
 		var vals *ast.List
 		if len(def.Tp.GetElems()) > 0 {
@@ -262,14 +299,25 @@ func (c *cc) convertCreateTableStmt(n *pcast.CreateTableStmt) ast.Node {
 				if value, ok := opt.Expr.(*driver.ValueExpr); ok {
 					comment = value.GetString()
 				}
+			case pcast.ColumnOptionPrimaryKey:
+				create.Indexes = append(create.Indexes, c.convertConstraint(&pcast.Constraint{
+					Tp:   pcast.ConstraintPrimaryKey,
+					Keys: []*pcast.IndexPartSpecification{{Column: def.Name}},
+				}).(*ast.IndexStmt))
+			case pcast.ColumnOptionUniqKey:
+				create.Indexes = append(create.Indexes, c.convertConstraint(&pcast.Constraint{
+					Tp:   pcast.ConstraintUniqKey,
+					Keys: []*pcast.IndexPartSpecification{{Column: def.Name}},
+				}).(*ast.IndexStmt))
 			}
 		}
 		columnDef := ast.ColumnDef{
-			Colname:   def.Name.String(),
-			TypeName:  &ast.TypeName{Name: types.TypeToStr(def.Tp.GetType(), def.Tp.GetCharset())},
-			IsNotNull: isNotNull(def),
-			Comment:   comment,
-			Vals:      vals,
+			Colname:    def.Name.String(),
+			TypeName:   &ast.TypeName{Name: types.TypeToStr(def.Tp.GetType(), def.Tp.GetCharset())},
+			IsNotNull:  isNotNull(def),
+			Comment:    comment,
+			Vals:       vals,
+			CollClause: columnCollation(def, tableCollation),
 		}
 		if def.Tp.GetFlen() >= 0 {
 			length := def.Tp.GetFlen()
@@ -277,6 +325,14 @@ func (c *cc) convertCreateTableStmt(n *pcast.CreateTableStmt) ast.Node {
 		}
 		create.Cols = append(create.Cols, &columnDef)
 	}
+	for _, constraint := range n.Constraints {
+		if index, ok := c.convertConstraint(constraint).(*ast.IndexStmt); ok {
+			create.Indexes = append(create.Indexes, index)
+		}
+	}
+	if n.Partition != nil {
+		create.Partition = c.convertPartitionOptions(n.Partition)
+	}
 	for _, opt := range n.Options {
 		switch opt.Tp {
 		case pcast.TableOptionComment:
@@ -436,7 +492,9 @@ func (c *cc) convertInsertStmt(n *pcast.InsertStmt) *ast.InsertStmt {
 			targetList.Items = append(targetList.Items, c.convertAssignment(a))
 		}
 		insert.OnConflictClause = &ast.OnConflictClause{
+			Action:     ast.OnConflictActionUpdate,
 			TargetList: targetList,
+			RowAlias:   c.rowAlias,
 			Location:   n.OriginTextPosition(),
 		}
 	}
@@ -486,24 +544,24 @@ func (c *cc) convertSelectField(n *pcast.SelectField) *ast.ResTarget {
 }
 
 func (c *cc) convertSelectStmt(n *pcast.SelectStmt) *ast.SelectStmt {
-	windowClause := &ast.List{Items: make([]ast.Node, 0)}
-	orderByClause := c.convertOrderByClause(n.OrderBy)
-	if orderByClause != nil {
-		windowClause.Items = append(windowClause.Items, orderByClause)
-	}
-
+	// Parameters are numbered in the order they are converted, so the
+	// clauses are converted in the order they are written
 	op, all := c.convertSetOprType(n.AfterSetOperator)
 	stmt := &ast.SelectStmt{
+		WithClause:   c.convertWithClause(n.With),
 		TargetList:   c.convertFieldList(n.Fields),
 		FromClause:   c.convertTableRefsClause(n.From),
+		WhereClause:  c.convert(n.Where),
 		GroupClause:  c.convertGroupByClause(n.GroupBy),
 		HavingClause: c.convertHavingClause(n.Having),
-		WhereClause:  c.convert(n.Where),
-		WithClause:   c.convertWithClause(n.With),
-		WindowClause: windowClause,
+		WindowClause: &ast.List{Items: make([]ast.Node, 0)},
 		Op:           op,
 		All:          all,
 	}
+	for i := range n.WindowSpecs {
+		stmt.WindowClause.Items = append(stmt.WindowClause.Items, c.convertWindowSpec(&n.WindowSpecs[i]))
+	}
+	stmt.SortClause = c.convertOrderByClause(n.OrderBy)
 	if n.Limit != nil {
 		stmt.LimitCount = c.convert(n.Limit.Count)
 		stmt.LimitOffset = c.convert(n.Limit.Offset)
@@ -604,6 +662,18 @@ func (c *cc) convertUpdateStmt(n *pcast.UpdateStmt) *ast.UpdateStmt {
 }
 
 func (c *cc) convertValueExpr(n *driver.ValueExpr) *ast.A_Const {
+	switch n.Datum.Kind() {
+	case driver.KindInt64:
+		return &ast.A_Const{Val: &ast.Integer{Ival: n.Datum.GetInt64()}}
+	case driver.KindUint64:
+		return &ast.A_Const{Val: &ast.Integer{Ival: int64(n.Datum.GetUint64())}}
+	case driver.KindFloat32, driver.KindFloat64:
+		return &ast.A_Const{Val: &ast.Float{Str: strconv.FormatFloat(n.Datum.GetFloat64(), 'g', -1, 64)}}
+	case driver.KindMysqlDecimal:
+		return &ast.A_Const{Val: &ast.Float{Str: n.Datum.GetMysqlDecimal().String()}}
+	case driver.KindNull:
+		return &ast.A_Const{Val: &ast.Null{}}
+	}
 	return &ast.A_Const{
 		Val: &ast.String{
 			Str: n.Datum.GetString(),
@@ -711,6 +781,16 @@ func (c *cc) convertByItem(n *pcast.ByItem) ast.Node {
 	}
 }
 
+func (c *cc) convertCallStmt(n *pcast.CallStmt) ast.Node {
+	fn, ok := c.convertFuncCallExpr(n.Procedure).(*ast.FuncCall)
+	if !ok {
+		return todo(n)
+	}
+	return &ast.CallStmt{
+		FuncCall: fn,
+	}
+}
+
 func (c *cc) convertCaseExpr(n *pcast.CaseExpr) ast.Node {
 	if n == nil {
 		return nil
@@ -755,7 +835,33 @@ func (c *cc) convertCompareSubqueryExpr(n *pcast.CompareSubqueryExpr) ast.Node {
 }
 
 func (c *cc) convertConstraint(n *pcast.Constraint) ast.Node {
-	return todo(n)
+	switch n.Tp {
+	case pcast.ConstraintPrimaryKey, pcast.ConstraintKey, pcast.ConstraintIndex,
+		pcast.ConstraintUniq, pcast.ConstraintUniqKey, pcast.ConstraintUniqIndex,
+		pcast.ConstraintFulltext:
+	default:
+		return todo(n)
+	}
+	index := &ast.IndexStmt{
+		IndexParams: &ast.List{},
+		IfNotExists: n.IfNotExists,
+	}
+	switch n.Tp {
+	case pcast.ConstraintPrimaryKey:
+		name := "primary"
+		index.Idxname = &name
+		index.Primary = true
+	case pcast.ConstraintUniq, pcast.ConstraintUniqKey, pcast.ConstraintUniqIndex:
+		index.Unique = true
+	}
+	if n.Name != "" && !index.Primary {
+		name := identifier(n.Name)
+		index.Idxname = &name
+	}
+	for _, key := range n.Keys {
+		index.IndexParams.Items = append(index.IndexParams.Items, c.convertIndexPartSpecification(key))
+	}
+	return index
 }
 
 func (c *cc) convertCreateBindingStmt(n *pcast.CreateBindingStmt) ast.Node {
@@ -770,11 +876,25 @@ func (c *cc) convertCreateDatabaseStmt(n *pcast.CreateDatabaseStmt) ast.Node {
 }
 
 func (c *cc) convertCreateIndexStmt(n *pcast.CreateIndexStmt) ast.Node {
-	return todo(n)
+	name := identifier(n.IndexName)
+	index := &ast.IndexStmt{
+		Idxname:     &name,
+		Relation:    c.convertTableName(n.Table),
+		IndexParams: &ast.List{},
+		Unique:      n.KeyType == pcast.IndexKeyTypeUnique,
+		IfNotExists: n.IfNotExists,
+	}
+	for _, key := range n.IndexPartSpecifications {
+		index.IndexParams.Items = append(index.IndexParams.Items, c.convertIndexPartSpecification(key))
+	}
+	return index
 }
 
 func (c *cc) convertCreateSequenceStmt(n *pcast.CreateSequenceStmt) ast.Node {
-	return todo(n)
+	return &ast.CreateSeqStmt{
+		Sequence:    c.convertTableName(n.Name),
+		IfNotExists: n.IfNotExists,
+	}
 }
 
 func (c *cc) convertCreateStatisticsStmt(n *pcast.CreateStatisticsStmt) ast.Node {
@@ -826,11 +946,19 @@ func (c *cc) convertDropDatabaseStmt(n *pcast.DropDatabaseStmt) ast.Node {
 }
 
 func (c *cc) convertDropIndexStmt(n *pcast.DropIndexStmt) ast.Node {
-	return todo(n)
+	return &ast.DropIndexStmt{
+		IfExists: n.IfExists,
+		Indexes:  []*ast.TableName{{Name: identifier(n.IndexName)}},
+		Table:    parseTableName(n.Table),
+	}
 }
 
 func (c *cc) convertDropSequenceStmt(n *pcast.DropSequenceStmt) ast.Node {
-	return todo(n)
+	drop := &ast.DropSequenceStmt{IfExists: n.IfExists}
+	for _, name := range n.Sequences {
+		drop.Sequences = append(drop.Sequences, parseTableName(name))
+	}
+	return drop
 }
 
 func (c *cc) convertDropStatisticsStmt(n *pcast.DropStatisticsStmt) ast.Node {
@@ -865,16 +993,63 @@ func (c *cc) convertFlushStmt(n *pcast.FlushStmt) ast.Node {
 	return todo(n)
 }
 
+// convertFrameBound converts the offset of a frame bound. The bound itself
+// is converted to frame options by convertFrame.
 func (c *cc) convertFrameBound(n *pcast.FrameBound) ast.Node {
-	return todo(n)
+	if n.Expr == nil {
+		return nil
+	}
+	return c.convert(n.Expr)
 }
 
 func (c *cc) convertFrameClause(n *pcast.FrameClause) ast.Node {
-	return todo(n)
+	def := &ast.WindowDef{
+		PartitionClause: &ast.List{},
+		OrderClause:     &ast.List{},
+	}
+	def.FrameOptions, def.StartOffset, def.EndOffset = c.convertFrame(n)
+	return def
 }
 
 func (c *cc) convertFuncCastExpr(n *pcast.FuncCastExpr) ast.Node {
-	return todo(n)
+	name := types.TypeToStr(n.Tp.GetType(), n.Tp.GetCharset())
+	// CAST(x AS CHAR) has no column type of its own
+	if n.Tp.GetType() == mysql.TypeVarString {
+		name = "varchar"
+		if n.Tp.GetCharset() == "binary" {
+			name = "varbinary"
+		}
+	}
+	var mods []int
+	switch name {
+	case "decimal":
+		mods = []int{n.Tp.GetFlen(), n.Tp.GetDecimal()}
+	case "varchar", "varbinary", "char", "binary":
+		if n.Tp.GetFlen() != types.UnspecifiedLength {
+			mods = []int{n.Tp.GetFlen()}
+		}
+	case "datetime", "time":
+		if n.Tp.GetDecimal() > 0 {
+			mods = []int{n.Tp.GetDecimal()}
+		}
+	}
+	var typmods *ast.List
+	for _, mod := range mods {
+		if typmods == nil {
+			typmods = &ast.List{}
+		}
+		typmods.Items = append(typmods.Items, &ast.A_Const{Val: &ast.Integer{Ival: int64(mod)}})
+	}
+	return &ast.TypeCast{
+		Arg: c.convert(n.Expr),
+		TypeName: &ast.TypeName{
+			Name:     name,
+			Names:    &ast.List{Items: []ast.Node{NewIdentifier(name)}},
+			Typmods:  typmods,
+			Unsigned: mysql.HasUnsignedFlag(n.Tp.GetFlag()),
+		},
+		Location: n.OriginTextPosition(),
+	}
 }
 
 func (c *cc) convertGetFormatSelectorExpr(n *pcast.GetFormatSelectorExpr) ast.Node {
@@ -920,15 +1095,50 @@ func (c *cc) convertIndexLockAndAlgorithm(n *pcast.IndexLockAndAlgorithm) ast.No
 }
 
 func (c *cc) convertIndexPartSpecification(n *pcast.IndexPartSpecification) ast.Node {
-	return todo(n)
+	elem := &ast.IndexElem{
+		Ordering: ast.SortByDirDefault,
+	}
+	if n.Desc {
+		elem.Ordering = ast.SortByDirDesc
+	}
+	if n.Column != nil {
+		name := identifier(n.Column.Name.String())
+		elem.Name = &name
+	} else {
+		elem.Expr = c.convert(n.Expr)
+	}
+	return elem
 }
 
 func (c *cc) convertIsNullExpr(n *pcast.IsNullExpr) ast.Node {
-	return todo(n)
+	typ := ast.NullTestTypeIsNull
+	if n.Not {
+		typ = ast.NullTestTypeIsNotNull
+	}
+	return &ast.NullTest{
+		Arg:          c.convert(n.Expr),
+		Nulltesttype: typ,
+		Location:     n.OriginTextPosition(),
+	}
 }
 
 func (c *cc) convertIsTruthExpr(n *pcast.IsTruthExpr) ast.Node {
-	return todo(n)
+	var typ ast.BoolTestType
+	switch {
+	case n.True != 0 && !n.Not:
+		typ = ast.BoolTestTypeIsTrue
+	case n.True != 0:
+		typ = ast.BoolTestTypeIsNotTrue
+	case !n.Not:
+		typ = ast.BoolTestTypeIsFalse
+	default:
+		typ = ast.BoolTestTypeIsNotFalse
+	}
+	return &ast.BooleanTest{
+		Arg:          c.convert(n.Expr),
+		Booltesttype: typ,
+		Location:     n.OriginTextPosition(),
+	}
 }
 
 func (c *cc) convertJoin(n *pcast.Join) *ast.List {
@@ -981,8 +1191,36 @@ func (c *cc) convertLockTablesStmt(n *pcast.LockTablesStmt) ast.Node {
 	return todo(n)
 }
 
+// convertMatchAgainst converts a full-text search to a call of the
+// MATCH_AGAINST function, which takes the search string followed by the
+// columns to search and then the search modifier, if there is one.
 func (c *cc) convertMatchAgainst(n *pcast.MatchAgainst) ast.Node {
-	return todo(n)
+	args := &ast.List{
+		Items: []ast.Node{c.convert(n.Against)},
+	}
+	for _, col := range n.ColumnNames {
+		args.Items = append(args.Items, c.convertColumnNameExpr(&pcast.ColumnNameExpr{Name: col}))
+	}
+	var modifier string
+	switch {
+	case n.Modifier.IsBooleanMode():
+		modifier = "IN BOOLEAN MODE"
+	case n.Modifier.WithQueryExpansion():
+		modifier = "WITH QUERY EXPANSION"
+	}
+	if modifier != "" {
+		args.Items = append(args.Items, &ast.A_Const{Val: &ast.String{Str: modifier}})
+	}
+	return &ast.FuncCall{
+		Func: &ast.FuncName{
+			Name: "match_against",
+		},
+		Funcname: &ast.List{
+			Items: []ast.Node{NewIdentifier("match_against")},
+		},
+		Args:     args,
+		Location: n.OriginTextPosition(),
+	}
 }
 
 func (c *cc) convertMaxValueExpr(n *pcast.MaxValueExpr) ast.Node {
@@ -1004,24 +1242,23 @@ func (c *cc) convertOnUpdateOpt(n *pcast.OnUpdateOpt) ast.Node {
 	return todo(n)
 }
 
-func (c *cc) convertOrderByClause(n *pcast.OrderByClause) ast.Node {
+func (c *cc) convertOrderByClause(n *pcast.OrderByClause) *ast.List {
+	list := &ast.List{Items: []ast.Node{}}
 	if n == nil {
-		return nil
+		return list
 	}
-	list := &ast.List{Items: []ast.Node{}}
 	for _, item := range n.Items {
-		switch item.Expr.(type) {
-		case *pcast.CaseExpr:
-			list.Items = append(list.Items, &ast.CaseWhen{
-				Expr:     c.convert(item.Expr),
-				Location: item.Expr.OriginTextPosition(),
-			})
-		case *pcast.ColumnNameExpr:
-			list.Items = append(list.Items, &ast.CaseExpr{
-				Xpr:      c.convert(item.Expr),
-				Location: item.Expr.OriginTextPosition(),
-			})
+		// The parser does not record an explicit ASC
+		dir := ast.SortByDirDefault
+		if item.Desc {
+			dir = ast.SortByDirDesc
 		}
+		list.Items = append(list.Items, &ast.SortBy{
+			Node:      c.convert(item.Expr),
+			SortbyDir: dir,
+			UseOp:     &ast.List{},
+			Location:  item.Expr.OriginTextPosition(),
+		})
 	}
 	return list
 }
@@ -1033,8 +1270,32 @@ func (c *cc) convertParenthesesExpr(n *pcast.ParenthesesExpr) ast.Node {
 	return c.convert(n.Expr)
 }
 
-func (c *cc) convertPartitionByClause(n *pcast.PartitionByClause) ast.Node {
-	return todo(n)
+func (c *cc) convertPartitionByClause(n *pcast.PartitionByClause) *ast.List {
+	list := &ast.List{}
+	for _, item := range n.Items {
+		list.Items = append(list.Items, c.convert(item.Expr))
+	}
+	return list
+}
+
+func (c *cc) convertPartitionOptions(n *pcast.PartitionOptions) *ast.PartitionSpec {
+	strategy := strings.ToLower(n.Tp.String())
+	spec := &ast.PartitionSpec{
+		Strategy:   &strategy,
+		PartParams: &ast.List{},
+	}
+	if n.Expr != nil {
+		spec.PartParams.Items = append(spec.PartParams.Items, &ast.PartitionElem{
+			Expr: c.convert(n.Expr),
+		})
+	}
+	for _, col := range n.ColumnNames {
+		name := identifier(col.Name.String())
+		spec.PartParams.Items = append(spec.PartParams.Items, &ast.PartitionElem{
+			Name: &name,
+		})
+	}
+	return spec
 }
 
 func (c *cc) convertPatternInExpr(n *pcast.PatternInExpr) ast.Node {
@@ -1065,7 +1326,7 @@ func (c *cc) convertPatternInExpr(n *pcast.PatternInExpr) ast.Node {
 
 func (c *cc) convertPatternLikeExpr(n *pcast.PatternLikeExpr) ast.Node {
 	return &ast.A_Expr{
-		Kind: ast.A_Expr_Kind(9),
+		Kind: ast.A_Expr_Kind_LIKE,
 		Name: &ast.List{
 			Items: []ast.Node{
 				&ast.String{Str: "~~"},
@@ -1077,11 +1338,33 @@ func (c *cc) convertPatternLikeExpr(n *pcast.PatternLikeExpr) ast.Node {
 }
 
 func (c *cc) convertPatternRegexpExpr(n *pcast.PatternRegexpExpr) ast.Node {
-	return todo(n)
+	op := "REGEXP"
+	if n.Not {
+		op = "NOT REGEXP"
+	}
+	return &ast.A_Expr{
+		Kind: ast.A_Expr_Kind_OP,
+		Name: &ast.List{
+			Items: []ast.Node{
+				&ast.String{Str: op},
+			},
+		},
+		Lexpr:    c.convert(n.Expr),
+		Rexpr:    c.convert(n.Pattern),
+		Location: n.OriginTextPosition(),
+	}
 }
 
+// convertPositionExpr converts a reference to an output column by its
+// position, as in ORDER BY 1.
 func (c *cc) convertPositionExpr(n *pcast.PositionExpr) ast.Node {
-	return todo(n)
+	if n.P != nil {
+		return c.convert(n.P)
+	}
+	return &ast.A_Const{
+		Val:      &ast.Integer{Ival: int64(n.N)},
+		Location: n.OriginTextPosition(),
+	}
 }
 
 func (c *cc) convertPrepareStmt(n *pcast.PrepareStmt) ast.Node {
@@ -1117,7 +1400,13 @@ func (c *cc) convertRollbackStmt(n *pcast.RollbackStmt) ast.Node {
 }
 
 func (c *cc) convertRowExpr(n *pcast.RowExpr) ast.Node {
-	return todo(n)
+	args := &ast.List{}
+	for _, v := range n.Values {
+		args.Items = append(args.Items, c.convert(v))
+	}
+	return &ast.RowExpr{
+		Args: args,
+	}
 }
 
 func (c *cc) convertSetCollationExpr(n *pcast.SetCollationExpr) ast.Node {
@@ -1301,16 +1590,26 @@ func (c *cc) convertTableToTable(n *pcast.TableToTable) ast.Node {
 	return todo(n)
 }
 
+// convertTimeUnitExpr converts the unit of an interval, as in
+// DATE_ADD(d, INTERVAL 1 DAY), to a string constant.
 func (c *cc) convertTimeUnitExpr(n *pcast.TimeUnitExpr) ast.Node {
-	return todo(n)
+	return &ast.A_Const{
+		Val:      &ast.String{Str: n.Unit.String()},
+		Location: n.OriginTextPosition(),
+	}
 }
 
 func (c *cc) convertTraceStmt(n *pcast.TraceStmt) ast.Node {
 	return todo(n)
 }
 
+// convertTrimDirectionExpr converts the BOTH, LEADING or TRAILING keyword
+// of TRIM to a string constant.
 func (c *cc) convertTrimDirectionExpr(n *pcast.TrimDirectionExpr) ast.Node {
-	return todo(n)
+	return &ast.A_Const{
+		Val:      &ast.String{Str: n.Direction.String()},
+		Location: n.OriginTextPosition(),
+	}
 }
 
 func (c *cc) convertTruncateTableStmt(n *pcast.TruncateTableStmt) *ast.TruncateStmt {
@@ -1320,6 +1619,33 @@ func (c *cc) convertTruncateTableStmt(n *pcast.TruncateTableStmt) *ast.TruncateS
 }
 
 func (c *cc) convertUnaryOperationExpr(n *pcast.UnaryOperationExpr) ast.Node {
+	switch n.Op {
+	case opcode.Not, opcode.Not2:
+		return &ast.BoolExpr{
+			Boolop: ast.BoolExprTypeNot,
+			Args: &ast.List{
+				Items: []ast.Node{c.convert(n.V)},
+			},
+			Location: n.OriginTextPosition(),
+		}
+	case opcode.Plus:
+		return c.convert(n.V)
+	case opcode.Minus, opcode.BitNeg:
+		op := "-"
+		if n.Op == opcode.BitNeg {
+			op = "~"
+		}
+		return &ast.A_Expr{
+			Kind: ast.A_Expr_Kind_OP,
+			Name: &ast.List{
+				Items: []ast.Node{
+					&ast.String{Str: op},
+				},
+			},
+			Rexpr:    c.convert(n.V),
+			Location: n.OriginTextPosition(),
+		}
+	}
 	return todo(n)
 }
 
@@ -1328,11 +1654,24 @@ func (c *cc) convertUnlockTablesStmt(n *pcast.UnlockTablesStmt) ast.Node {
 }
 
 func (c *cc) convertUseStmt(n *pcast.UseStmt) ast.Node {
-	return todo(n)
+	return &ast.UseStmt{Schema: identifier(n.DBName)}
 }
 
+// convertValuesExpr converts VALUES(col), the value an INSERT ... ON
+// DUPLICATE KEY UPDATE statement would have inserted into the column.
 func (c *cc) convertValuesExpr(n *pcast.ValuesExpr) ast.Node {
-	return todo(n)
+	return &ast.FuncCall{
+		Func: &ast.FuncName{
+			Name: "values",
+		},
+		Funcname: &ast.List{
+			Items: []ast.Node{NewIdentifier("values")},
+		},
+		Args: &ast.List{
+			Items: []ast.Node{c.convertColumnNameExpr(n.Column)},
+		},
+		Location: n.OriginTextPosition(),
+	}
 }
 
 func (c *cc) convertVariableAssignment(n *pcast.VariableAssignment) ast.Node {
@@ -1340,7 +1679,15 @@ func (c *cc) convertVariableAssignment(n *pcast.VariableAssignment) ast.Node {
 }
 
 func (c *cc) convertVariableExpr(n *pcast.VariableExpr) ast.Node {
-	return todo(n)
+	// Assignments such as @a := 1 aren't supported
+	if n.Value != nil {
+		return todo(n)
+	}
+	return &ast.VariableExpr{
+		Name:     n.Name,
+		IsSystem: n.IsSystem,
+		Location: n.OriginTextPosition(),
+	}
 }
 
 func (c *cc) convertWhenClause(n *pcast.WhenClause) ast.Node {
@@ -1355,11 +1702,109 @@ func (c *cc) convertWhenClause(n *pcast.WhenClause) ast.Node {
 }
 
 func (c *cc) convertWindowFuncExpr(n *pcast.WindowFuncExpr) ast.Node {
-	return todo(n)
+	name := strings.ToLower(n.F)
+	fn := &ast.FuncCall{
+		Func: &ast.FuncName{
+			Name: name,
+		},
+		Funcname: &ast.List{
+			Items: []ast.Node{
+				NewIdentifier(name),
+			},
+		},
+		Args:        &ast.List{},
+		AggOrder:    &ast.List{},
+		AggDistinct: n.Distinct,
+		Over:        c.convertWindowSpec(&n.Spec),
+		Location:    n.OriginTextPosition(),
+	}
+	for _, a := range n.Args {
+		// COUNT(*) is parsed as COUNT(1)
+		if value, ok := a.(*driver.ValueExpr); ok && name == "count" && len(n.Args) == 1 {
+			if value.GetInt64() == int64(1) {
+				fn.AggStar = true
+				continue
+			}
+		}
+		fn.Args.Items = append(fn.Args.Items, c.convert(a))
+	}
+	return fn
 }
 
-func (c *cc) convertWindowSpec(n *pcast.WindowSpec) ast.Node {
-	return todo(n)
+func (c *cc) convertWindowSpec(n *pcast.WindowSpec) *ast.WindowDef {
+	def := &ast.WindowDef{
+		PartitionClause: &ast.List{},
+		OrderClause:     &ast.List{},
+		FrameOptions:    ast.FrameOptionRange | ast.FrameOptionStartUnboundedPreceding | ast.FrameOptionEndCurrentRow,
+	}
+	if n.OnlyAlias {
+		// OVER w refers to a window of the WINDOW clause
+		name := n.Name.String()
+		def.Name = &name
+		return def
+	}
+	if n.Name.String() != "" {
+		name := n.Name.String()
+		def.Name = &name
+	}
+	if n.Ref.String() != "" {
+		ref := n.Ref.String()
+		def.Refname = &ref
+	}
+	if n.PartitionBy != nil {
+		def.PartitionClause = c.convertPartitionByClause(n.PartitionBy)
+	}
+	if n.OrderBy != nil {
+		def.OrderClause = c.convertOrderByClause(n.OrderBy)
+	}
+	if n.Frame != nil {
+		def.FrameOptions, def.StartOffset, def.EndOffset = c.convertFrame(n.Frame)
+	}
+	return def
+}
+
+// convertFrame returns the frame options of a window together with the
+// offsets of its start and end.
+func (c *cc) convertFrame(n *pcast.FrameClause) (int, ast.Node, ast.Node) {
+	opts := ast.FrameOptionNonDefault | ast.FrameOptionBetween
+	switch n.Type {
+	case pcast.Rows:
+		opts |= ast.FrameOptionRows
+	case pcast.Ranges:
+		opts |= ast.FrameOptionRange
+	case pcast.Groups:
+		opts |= ast.FrameOptionGroups
+	}
+	var start, end ast.Node
+	switch b := n.Extent.Start; {
+	case b.Type == pcast.CurrentRow:
+		opts |= ast.FrameOptionStartCurrentRow
+	case b.UnBounded && b.Type == pcast.Preceding:
+		opts |= ast.FrameOptionStartUnboundedPreceding
+	case b.UnBounded:
+		opts |= ast.FrameOptionStartUnboundedFollowing
+	case b.Type == pcast.Preceding:
+		opts |= ast.FrameOptionStartOffsetPreceding
+		start = c.convert(b.Expr)
+	default:
+		opts |= ast.FrameOptionStartOffsetFollowing
+		start = c.convert(b.Expr)
+	}
+	switch b := n.Extent.End; {
+	case b.Type == pcast.CurrentRow:
+		opts |= ast.FrameOptionEndCurrentRow
+	case b.UnBounded && b.Type == pcast.Preceding:
+		opts |= ast.FrameOptionEndUnboundedPreceding
+	case b.UnBounded:
+		opts |= ast.FrameOptionEndUnboundedFollowing
+	case b.Type == pcast.Preceding:
+		opts |= ast.FrameOptionEndOffsetPreceding
+		end = c.convert(b.Expr)
+	default:
+		opts |= ast.FrameOptionEndOffsetFollowing
+		end = c.convert(b.Expr)
+	}
+	return opts, start, end
 }
 
 func (c *cc) convert(node pcast.Node) ast.Node {
@@ -1416,6 +1861,9 @@ func (c *cc) convert(node pcast.Node) ast.Node {
 	case *pcast.ByItem:
 		return c.convertByItem(n)
 
+	case *pcast.CallStmt:
+		return c.convertCallStmt(n)
+
 	case *pcast.CaseExpr:
 		return c.convertCaseExpr(n)
 
@@ -0,0 +1,106 @@
+package dolphin
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/stephenwithav/sqlc/pkg/sql/ast"
+)
+
+func TestDeparse(t *testing.T) {
+	p := NewParser()
+	for _, tc := range []struct {
+		in  string
+		out string
+	}{
+		{
+			"select id, name from authors where id = ? limit 1",
+			"SELECT id, name FROM authors WHERE id = ? LIMIT 1",
+		},
+		{
+			"select a.id, b.title from authors a left join books b on b.author_id = a.id order by a.id desc, b.title limit 3 offset 2",
+			"SELECT a.id, b.title FROM authors AS a LEFT JOIN books AS b ON b.author_id = a.id ORDER BY a.id DESC, b.title LIMIT 3 OFFSET 2",
+		},
+		{
+			"select name, count(*) from authors where name like 'a%' and id in (1, 2) group by name having count(*) > 1",
+			"SELECT name, count(*) FROM authors WHERE name LIKE 'a%' AND id IN (1, 2) GROUP BY name HAVING count(*) > 1",
+		},
+		{
+			"insert into authors (name, bio) values (?, 'it''s') on duplicate key update bio = values(bio)",
+			"INSERT INTO authors (name, bio) VALUES (?, 'it''s') ON DUPLICATE KEY UPDATE bio = values(bio)",
+		},
+		{
+			"insert into authors (name, bio) values (?, ') as x on duplicate') as new on duplicate key update bio = new.bio",
+			"INSERT INTO authors (name, bio) VALUES (?, ') as x on duplicate') AS new ON DUPLICATE KEY UPDATE bio = new.bio",
+		},
+		{
+			"insert into authors (name, bio) values (?, ?) as `New` (n, `b`) /* as old */ on duplicate key update bio = b",
+			"INSERT INTO authors (name, bio) VALUES (?, ?) AS new(n, b) ON DUPLICATE KEY UPDATE bio = b",
+		},
+		{
+			"insert into authors (name, bio) select * from (select name, bio from drafts) as dt on duplicate key update bio = dt.bio",
+			"INSERT INTO authors (name, bio) SELECT * FROM (SELECT name, bio FROM drafts) AS dt ON DUPLICATE KEY UPDATE bio = dt.bio",
+		},
+		{
+			"update authors set name = ? where id = ?",
+			"UPDATE authors SET name = ? WHERE id = ?",
+		},
+		{
+			"select id, row_number() over (partition by name order by id desc) from app.authors",
+			"SELECT id, row_number() OVER (PARTITION BY name ORDER BY id DESC) FROM app.authors",
+		},
+		{
+			"select id from posts where title regexp ? and body not rlike ?",
+			"SELECT id FROM posts WHERE title REGEXP ? AND body NOT REGEXP ?",
+		},
+		{
+			"select cast(price as signed), cast(price as unsigned), cast(price as decimal(10, 2)), cast(id as char(8)), cast(body as binary), cast(published as datetime(3))",
+			"SELECT CAST(price AS signed), CAST(price AS unsigned), CAST(price AS decimal(10, 2)), CAST(id AS char(8)), CAST(body AS binary), CAST(published AS datetime(3))",
+		},
+		{
+			"select trim(leading 'x' from title), trim('y' from title), trim(title), position('a' in title), char(77, 121 using utf8mb4), char(77)",
+			"SELECT trim(LEADING 'x' FROM title), trim('y' FROM title), trim(title), position('a' IN title), char(77, 121 USING utf8mb4), char(77)",
+		},
+		{
+			"select date_add(published, interval 7 day), published - interval 1 hour, extract(year from published), timestampdiff(day, published, ?)",
+			"SELECT date_add(published, INTERVAL 7 DAY), date_sub(published, INTERVAL 1 HOUR), extract(YEAR FROM published), timestampdiff(DAY, published, ?)",
+		},
+		{
+			"select match (title, body) against (?), match (title) against ('x' in boolean mode) from posts",
+			"SELECT MATCH (title, body) AGAINST (?), MATCH (title) AGAINST ('x' IN BOOLEAN MODE) FROM posts",
+		},
+	} {
+		stmts, err := p.Parse(strings.NewReader(tc.in))
+		if err != nil {
+			t.Fatal(err)
+		}
+		out, err := ast.Format(stmts[0].Raw.Stmt, p)
+		if err != nil {
+			t.Errorf("%s: %s", tc.in, err)
+			continue
+		}
+		if out != tc.out {
+			t.Errorf("deparse of %q\n got: %s\nwant: %s", tc.in, out, tc.out)
+		}
+		again, err := p.Parse(strings.NewReader(out))
+		if err != nil {
+			t.Errorf("%s: %s", out, err)
+			continue
+		}
+		if got, err := ast.Format(again[0].Raw.Stmt, p); err != nil || got != out {
+			t.Errorf("deparse of %q is not stable: %s %v", out, got, err)
+		}
+		want, err := p.parseOne(tc.in)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := p.parseOne(out)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !sameNode(reflect.ValueOf(want), reflect.ValueOf(got), map[[2]uintptr]bool{}) {
+			t.Errorf("deparse of %q changed its meaning: %s", tc.in, out)
+		}
+	}
+}
@@ -0,0 +1,143 @@
+package dolphin
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/stephenwithav/sqlc/pkg/sql/ast"
+)
+
+func (p *Parser) QuoteIdent(s string) string {
+	if p.IsReservedKeyword(s) || !isSimpleIdent(s) {
+		return "`" + strings.ReplaceAll(s, "`", "``") + "`"
+	}
+	return s
+}
+
+func isSimpleIdent(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i, r := range s {
+		switch {
+		case r == '_' || r == '$' || ('a' <= r && r <= 'z') || ('A' <= r && r <= 'Z'):
+		case i > 0 && '0' <= r && r <= '9':
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+func (p *Parser) TypeName(ns, name string) string {
+	if ns != "" {
+		return fmt.Sprintf("%s.%s", p.QuoteIdent(ns), name)
+	}
+	return name
+}
+
+func (p *Parser) Param(n int) string {
+	return "?"
+}
+
+// Cast writes the target types CAST names differently from column types:
+// the integer types are SIGNED or UNSIGNED, and the character and byte
+// strings are CHAR and BINARY.
+func (p *Parser) Cast(arg, typeName string) string {
+	name, mods := typeName, ""
+	if i := strings.IndexAny(typeName, "( "); i >= 0 {
+		name, mods = typeName[:i], typeName[i:]
+	}
+	switch name {
+	case "tinyint", "smallint", "mediumint", "int", "bigint":
+		name, mods = "signed", ""
+		if strings.HasSuffix(typeName, " unsigned") {
+			name = "unsigned"
+		}
+	case "varchar":
+		name = "char"
+	case "varbinary":
+		name = "binary"
+	}
+	return fmt.Sprintf("CAST(%s AS %s%s)", arg, name, mods)
+}
+
+func (p *Parser) OnDuplicateKey() bool {
+	return true
+}
+
+// FuncCall writes the functions the converter turns into calls from MySQL's
+// own syntax, whose interval units, TRIM directions, character sets and
+// full-text search modifiers are string constants among the arguments.
+func (p *Parser) FuncCall(n *ast.FuncCall, args []string) (string, bool) {
+	if n.Func == nil || n.Func.Schema != "" {
+		return "", false
+	}
+	switch n.Func.Name {
+	case "date_add", "date_sub", "adddate", "subdate":
+		if len(args) != 3 || !isKeyword(n.Args.Items[2]) {
+			break
+		}
+		return fmt.Sprintf("%s(%s, INTERVAL %s %s)", n.Func.Name, args[0], args[1], keyword(n.Args.Items[2])), true
+	case "extract":
+		if len(args) != 2 || !isKeyword(n.Args.Items[0]) {
+			break
+		}
+		return fmt.Sprintf("extract(%s FROM %s)", keyword(n.Args.Items[0]), args[1]), true
+	case "timestampadd", "timestampdiff":
+		if len(args) != 3 || !isKeyword(n.Args.Items[0]) {
+			break
+		}
+		return fmt.Sprintf("%s(%s, %s, %s)", n.Func.Name, keyword(n.Args.Items[0]), args[1], args[2]), true
+	case "trim":
+		switch len(args) {
+		case 2:
+			return fmt.Sprintf("trim(%s FROM %s)", args[1], args[0]), true
+		case 3:
+			if !isKeyword(n.Args.Items[2]) {
+				break
+			}
+			return fmt.Sprintf("trim(%s %s FROM %s)", keyword(n.Args.Items[2]), args[1], args[0]), true
+		}
+	case "position":
+		if len(args) != 2 {
+			break
+		}
+		return fmt.Sprintf("position(%s IN %s)", args[0], args[1]), true
+	case "char_func":
+		if len(args) < 2 {
+			break
+		}
+		last := n.Args.Items[len(args)-1]
+		if isKeyword(last) {
+			return fmt.Sprintf("char(%s USING %s)", strings.Join(args[:len(args)-1], ", "), keyword(last)), true
+		}
+		return fmt.Sprintf("char(%s)", strings.Join(args[:len(args)-1], ", ")), true
+	case "match_against":
+		if len(args) < 2 {
+			break
+		}
+		search, cols := args[0], args[1:]
+		if isKeyword(n.Args.Items[len(args)-1]) {
+			search += " " + keyword(n.Args.Items[len(args)-1])
+			cols = cols[:len(cols)-1]
+		}
+		return fmt.Sprintf("MATCH (%s) AGAINST (%s)", strings.Join(cols, ", "), search), true
+	}
+	return "", false
+}
+
+// isKeyword reports whether an argument is a string constant standing for
+// the keywords of a call.
+func isKeyword(n ast.Node) bool {
+	c, ok := n.(*ast.A_Const)
+	if !ok {
+		return false
+	}
+	_, ok = c.Val.(*ast.String)
+	return ok
+}
+
+func keyword(n ast.Node) string {
+	return n.(*ast.A_Const).Val.(*ast.String).Str
+}
@@ -0,0 +1,174 @@
+package dolphin
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	pcast "github.com/pingcap/tidb/parser/ast"
+	"github.com/pingcap/tidb/parser/format"
+)
+
+const restoreFlags = format.RestoreStringSingleQuotes |
+	format.RestoreStringWithoutCharset |
+	format.RestoreKeyWordUppercase |
+	format.RestoreSpacesAroundBinaryOperation
+
+// Format restores a single statement into its canonical form. The result is
+// rejected unless it parses back to the same statement, constants included.
+func (p *Parser) Format(sql string) (string, error) {
+	if _, aliases := hideRowAliases(sql); len(aliases) > 0 {
+		return "", fmt.Errorf("row aliases cannot be restored")
+	}
+	in, err := p.parseOne(sql)
+	if err != nil {
+		return "", err
+	}
+	out, err := restoreNode(in)
+	if err != nil {
+		return "", err
+	}
+	back, err := p.parseOne(out)
+	if err != nil {
+		return "", err
+	}
+	if !sameNode(reflect.ValueOf(in), reflect.ValueOf(back), map[[2]uintptr]bool{}) {
+		return "", fmt.Errorf("formatting changed the meaning of the statement")
+	}
+	return out, nil
+}
+
+func (p *Parser) parseOne(sql string) (pcast.StmtNode, error) {
+	sql, _ = hideRowAliases(sql)
+	stmtNodes, _, err := p.pingcap.Parse(sql, "", "")
+	if err != nil {
+		return nil, normalizeErr(err)
+	}
+	if len(stmtNodes) != 1 {
+		return nil, fmt.Errorf("expected one statement; got %d", len(stmtNodes))
+	}
+	return stmtNodes[0], nil
+}
+
+func restoreNode(node pcast.Node) (string, error) {
+	var b strings.Builder
+	if err := node.Restore(format.NewRestoreCtx(restoreFlags, &b)); err != nil {
+		return "", err
+	}
+	return b.String(), nil
+}
+
+// positionField reports whether a struct field only records where a node was
+// found in the source text, or caches that text.
+func positionField(f reflect.StructField) bool {
+	switch f.Name {
+	case "utf8Text", "enc", "once", "text", "offset", "projectionOffset":
+		return true
+	case "Offset":
+		// Limit.Offset is an expression; the int fields are positions
+		return f.Type.Kind() == reflect.Int
+	}
+	return false
+}
+
+// functionName reports whether a struct field holds the name of a function,
+// which MySQL matches without regard to case and restore prints upper case.
+func functionName(t reflect.Type, f reflect.StructField) bool {
+	switch t {
+	case reflect.TypeOf(pcast.FuncCallExpr{}):
+		return f.Name == "FnName"
+	case reflect.TypeOf(pcast.AggregateFuncExpr{}):
+		return f.Name == "F"
+	case reflect.TypeOf(pcast.WindowFuncExpr{}):
+		return f.Name == "Name"
+	}
+	return false
+}
+
+// sameNode compares two parsed statements field by field, ignoring positions.
+// Unexported fields are read through their kind-specific accessors, which
+// reflect allows where Interface does not.
+func sameNode(a, b reflect.Value, seen map[[2]uintptr]bool) bool {
+	if a.IsValid() != b.IsValid() {
+		return false
+	}
+	if !a.IsValid() {
+		return true
+	}
+	if a.Type() != b.Type() {
+		return false
+	}
+	switch a.Kind() {
+	case reflect.Ptr:
+		if a.IsNil() || b.IsNil() {
+			return a.IsNil() == b.IsNil()
+		}
+		key := [2]uintptr{a.Pointer(), b.Pointer()}
+		if seen[key] {
+			return true
+		}
+		seen[key] = true
+		return sameNode(a.Elem(), b.Elem(), seen)
+	case reflect.Interface:
+		if a.IsNil() || b.IsNil() {
+			return a.IsNil() == b.IsNil()
+		}
+		return sameNode(a.Elem(), b.Elem(), seen)
+	case reflect.Struct:
+		for i := 0; i < a.NumField(); i++ {
+			f := a.Type().Field(i)
+			if positionField(f) {
+				continue
+			}
+			if functionName(a.Type(), f) {
+				if !strings.EqualFold(fmt.Sprint(a.Field(i)), fmt.Sprint(b.Field(i))) {
+					return false
+				}
+				continue
+			}
+			if !sameNode(a.Field(i), b.Field(i), seen) {
+				return false
+			}
+		}
+		return true
+	case reflect.Slice:
+		if a.IsNil() != b.IsNil() && (a.Len() != 0 || b.Len() != 0) {
+			return false
+		}
+		fallthrough
+	case reflect.Array:
+		if a.Len() != b.Len() {
+			return false
+		}
+		for i := 0; i < a.Len(); i++ {
+			if !sameNode(a.Index(i), b.Index(i), seen) {
+				return false
+			}
+		}
+		return true
+	case reflect.Map:
+		if a.Len() != b.Len() {
+			return false
+		}
+		iter := a.MapRange()
+		for iter.Next() {
+			if !sameNode(iter.Value(), b.MapIndex(iter.Key()), seen) {
+				return false
+			}
+		}
+		return true
+	case reflect.Bool:
+		return a.Bool() == b.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return a.Int() == b.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return a.Uint() == b.Uint()
+	case reflect.Float32, reflect.Float64:
+		return a.Float() == b.Float()
+	case reflect.String:
+		return a.String() == b.String()
+	case reflect.Func, reflect.Chan, reflect.UnsafePointer:
+		return a.IsNil() == b.IsNil()
+	}
+	return false
+}
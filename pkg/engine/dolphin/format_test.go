@@ -0,0 +1,69 @@
+package dolphin
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFormat(t *testing.T) {
+	p := NewParser()
+	for _, tc := range []struct {
+		in  string
+		out string
+	}{
+		{
+			"select * from authors where name like concat('%', sqlc.arg(name)) order by name",
+			"SELECT * FROM authors WHERE name LIKE CONCAT('%', sqlc.arg(name)) ORDER BY name",
+		},
+		{
+			"select a, count(*) from t where b in (1, 2) group by a limit 3 offset 2",
+			"SELECT a,COUNT(1) FROM t WHERE b IN (1,2) GROUP BY a LIMIT 2,3",
+		},
+		{
+			"insert into t (a, b) values (?, 1.5) on duplicate key update b = values(b)",
+			"INSERT INTO t (a,b) VALUES (?,1.5) ON DUPLICATE KEY UPDATE b=VALUES(b)",
+		},
+	} {
+		out, err := p.Format(tc.in)
+		if err != nil {
+			t.Errorf("Format(%q): %s", tc.in, err)
+			continue
+		}
+		if out != tc.out {
+			t.Errorf("Format(%q) = %q; want %q", tc.in, out, tc.out)
+		}
+	}
+}
+
+func TestFormatRowAlias(t *testing.T) {
+	// Restoring the statement would drop its row alias
+	in := "insert into t (a, b) values (?, 1) as new on duplicate key update b = new.b"
+	if out, err := NewParser().Format(in); err == nil {
+		t.Errorf("Format(%q) = %q; want an error", in, out)
+	}
+}
+
+func TestSameNode(t *testing.T) {
+	p := NewParser()
+	for _, tc := range []struct {
+		a, b string
+		same bool
+	}{
+		{"select id from authors where id = ?", "SELECT id\nFROM   authors WHERE id = ?", true},
+		{"SELECT 1", "SELECT 2", false},
+		{"SELECT * FROM authors WHERE name = 'a'", "SELECT * FROM authors WHERE name = 'b'", false},
+		{"SELECT * FROM authors LIMIT 10", "SELECT * FROM authors LIMIT 10, 10", false},
+	} {
+		a, err := p.parseOne(tc.a)
+		if err != nil {
+			t.Fatal(err)
+		}
+		b, err := p.parseOne(tc.b)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := sameNode(reflect.ValueOf(a), reflect.ValueOf(b), map[[2]uintptr]bool{}); got != tc.same {
+			t.Errorf("sameNode(%q, %q) = %v; want %v", tc.a, tc.b, got, tc.same)
+		}
+	}
+}
@@ -0,0 +1,321 @@
+package dolphin
+
+import (
+	"github.com/stephenwithav/sqlc/pkg/sql/ast"
+	"github.com/stephenwithav/sqlc/pkg/sql/catalog"
+)
+
+// informationSchema describes the views of the information_schema database,
+// which every MySQL server provides.
+func informationSchema() *catalog.Schema {
+	return &catalog.Schema{
+		Name: "information_schema",
+		Tables: []*catalog.Table{
+			informationTable("character_sets",
+				informationColumn("character_set_name", "varchar", true),
+				informationColumn("default_collate_name", "varchar", true),
+				informationColumn("description", "varchar", true),
+				informationColumn("maxlen", "int", true),
+			),
+			informationTable("collations",
+				informationColumn("collation_name", "varchar", true),
+				informationColumn("character_set_name", "varchar", true),
+				informationColumn("id", "bigint", true),
+				informationColumn("is_default", "varchar", true),
+				informationColumn("is_compiled", "varchar", true),
+				informationColumn("sortlen", "int", true),
+				informationColumn("pad_attribute", "varchar", true),
+			),
+			informationTable("columns",
+				informationColumn("table_catalog", "varchar", true),
+				informationColumn("table_schema", "varchar", true),
+				informationColumn("table_name", "varchar", true),
+				informationColumn("column_name", "varchar", false),
+				informationColumn("ordinal_position", "int", true),
+				informationColumn("column_default", "text", false),
+				informationColumn("is_nullable", "varchar", true),
+				informationColumn("data_type", "longtext", false),
+				informationColumn("character_maximum_length", "bigint", false),
+				informationColumn("character_octet_length", "bigint", false),
+				informationColumn("numeric_precision", "bigint", false),
+				informationColumn("numeric_scale", "bigint", false),
+				informationColumn("datetime_precision", "int", false),
+				informationColumn("character_set_name", "varchar", false),
+				informationColumn("collation_name", "varchar", false),
+				informationColumn("column_type", "text", true),
+				informationColumn("column_key", "varchar", true),
+				informationColumn("extra", "varchar", false),
+				informationColumn("privileges", "varchar", false),
+				informationColumn("column_comment", "text", true),
+				informationColumn("generation_expression", "longtext", true),
+				informationColumn("srs_id", "int", false),
+			),
+			informationTable("engines",
+				informationColumn("engine", "varchar", true),
+				informationColumn("support", "varchar", true),
+				informationColumn("comment", "varchar", true),
+				informationColumn("transactions", "varchar", false),
+				informationColumn("xa", "varchar", false),
+				informationColumn("savepoints", "varchar", false),
+			),
+			informationTable("events",
+				informationColumn("event_catalog", "varchar", true),
+				informationColumn("event_schema", "varchar", true),
+				informationColumn("event_name", "varchar", true),
+				informationColumn("definer", "varchar", true),
+				informationColumn("time_zone", "varchar", true),
+				informationColumn("event_body", "varchar", true),
+				informationColumn("event_definition", "longtext", true),
+				informationColumn("event_type", "varchar", true),
+				informationColumn("execute_at", "datetime", false),
+				informationColumn("interval_value", "varchar", false),
+				informationColumn("interval_field", "varchar", false),
+				informationColumn("sql_mode", "varchar", true),
+				informationColumn("starts", "datetime", false),
+				informationColumn("ends", "datetime", false),
+				informationColumn("status", "varchar", true),
+				informationColumn("on_completion", "varchar", true),
+				informationColumn("created", "timestamp", true),
+				informationColumn("last_altered", "timestamp", true),
+				informationColumn("last_executed", "datetime", false),
+				informationColumn("event_comment", "varchar", true),
+				informationColumn("originator", "int", true),
+				informationColumn("character_set_client", "varchar", true),
+				informationColumn("collation_connection", "varchar", true),
+				informationColumn("database_collation", "varchar", true),
+			),
+			informationTable("key_column_usage",
+				informationColumn("constraint_catalog", "varchar", true),
+				informationColumn("constraint_schema", "varchar", true),
+				informationColumn("constraint_name", "varchar", false),
+				informationColumn("table_catalog", "varchar", true),
+				informationColumn("table_schema", "varchar", true),
+				informationColumn("table_name", "varchar", true),
+				informationColumn("column_name", "varchar", false),
+				informationColumn("ordinal_position", "int", true),
+				informationColumn("position_in_unique_constraint", "int", false),
+				informationColumn("referenced_table_schema", "varchar", false),
+				informationColumn("referenced_table_name", "varchar", false),
+				informationColumn("referenced_column_name", "varchar", false),
+			),
+			informationTable("parameters",
+				informationColumn("specific_catalog", "varchar", true),
+				informationColumn("specific_schema", "varchar", true),
+				informationColumn("specific_name", "varchar", true),
+				informationColumn("ordinal_position", "int", true),
+				informationColumn("parameter_mode", "varchar", false),
+				informationColumn("parameter_name", "varchar", false),
+				informationColumn("data_type", "longtext", false),
+				informationColumn("character_maximum_length", "bigint", false),
+				informationColumn("character_octet_length", "bigint", false),
+				informationColumn("numeric_precision", "int", false),
+				informationColumn("numeric_scale", "bigint", false),
+				informationColumn("datetime_precision", "int", false),
+				informationColumn("character_set_name", "varchar", false),
+				informationColumn("collation_name", "varchar", false),
+				informationColumn("dtd_identifier", "text", true),
+				informationColumn("routine_type", "varchar", true),
+			),
+			informationTable("partitions",
+				informationColumn("table_catalog", "varchar", true),
+				informationColumn("table_schema", "varchar", true),
+				informationColumn("table_name", "varchar", true),
+				informationColumn("partition_name", "varchar", false),
+				informationColumn("subpartition_name", "varchar", false),
+				informationColumn("partition_ordinal_position", "int", false),
+				informationColumn("subpartition_ordinal_position", "int", false),
+				informationColumn("partition_method", "varchar", false),
+				informationColumn("subpartition_method", "varchar", false),
+				informationColumn("partition_expression", "varchar", false),
+				informationColumn("subpartition_expression", "varchar", false),
+				informationColumn("partition_description", "text", false),
+				informationColumn("table_rows", "bigint", false),
+				informationColumn("avg_row_length", "bigint", false),
+				informationColumn("data_length", "bigint", false),
+				informationColumn("max_data_length", "bigint", false),
+				informationColumn("index_length", "bigint", false),
+				informationColumn("data_free", "bigint", false),
+				informationColumn("create_time", "timestamp", true),
+				informationColumn("update_time", "datetime", false),
+				informationColumn("check_time", "datetime", false),
+				informationColumn("checksum", "bigint", false),
+				informationColumn("partition_comment", "text", true),
+				informationColumn("nodegroup", "varchar", false),
+				informationColumn("tablespace_name", "varchar", false),
+			),
+			informationTable("processlist",
+				informationColumn("id", "bigint", true),
+				informationColumn("user", "varchar", true),
+				informationColumn("host", "varchar", true),
+				informationColumn("db", "varchar", false),
+				informationColumn("command", "varchar", true),
+				informationColumn("time", "int", true),
+				informationColumn("state", "varchar", false),
+				informationColumn("info", "longtext", false),
+			),
+			informationTable("referential_constraints",
+				informationColumn("constraint_catalog", "varchar", true),
+				informationColumn("constraint_schema", "varchar", true),
+				informationColumn("constraint_name", "varchar", false),
+				informationColumn("unique_constraint_catalog", "varchar", true),
+				informationColumn("unique_constraint_schema", "varchar", true),
+				informationColumn("unique_constraint_name", "varchar", false),
+				informationColumn("match_option", "varchar", true),
+				informationColumn("update_rule", "varchar", true),
+				informationColumn("delete_rule", "varchar", true),
+				informationColumn("table_name", "varchar", true),
+				informationColumn("referenced_table_name", "varchar", true),
+			),
+			informationTable("routines",
+				informationColumn("specific_name", "varchar", true),
+				informationColumn("routine_catalog", "varchar", true),
+				informationColumn("routine_schema", "varchar", true),
+				informationColumn("routine_name", "varchar", true),
+				informationColumn("routine_type", "varchar", true),
+				informationColumn("data_type", "longtext", false),
+				informationColumn("character_maximum_length", "bigint", false),
+				informationColumn("character_octet_length", "bigint", false),
+				informationColumn("numeric_precision", "int", false),
+				informationColumn("numeric_scale", "int", false),
+				informationColumn("datetime_precision", "int", false),
+				informationColumn("character_set_name", "varchar", false),
+				informationColumn("collation_name", "varchar", false),
+				informationColumn("dtd_identifier", "longtext", false),
+				informationColumn("routine_body", "varchar", true),
+				informationColumn("routine_definition", "longtext", false),
+				informationColumn("external_name", "varchar", false),
+				informationColumn("external_language", "varchar", true),
+				informationColumn("parameter_style", "varchar", true),
+				informationColumn("is_deterministic", "varchar", true),
+				informationColumn("sql_data_access", "varchar", true),
+				informationColumn("sql_path", "varchar", false),
+				informationColumn("security_type", "varchar", true),
+				informationColumn("created", "timestamp", true),
+				informationColumn("last_altered", "timestamp", true),
+				informationColumn("sql_mode", "varchar", true),
+				informationColumn("routine_comment", "text", true),
+				informationColumn("definer", "varchar", true),
+				informationColumn("character_set_client", "varchar", true),
+				informationColumn("collation_connection", "varchar", true),
+				informationColumn("database_collation", "varchar", true),
+			),
+			informationTable("schemata",
+				informationColumn("catalog_name", "varchar", true),
+				informationColumn("schema_name", "varchar", true),
+				informationColumn("default_character_set_name", "varchar", true),
+				informationColumn("default_collation_name", "varchar", true),
+				informationColumn("sql_path", "varchar", false),
+				informationColumn("default_encryption", "varchar", true),
+			),
+			informationTable("statistics",
+				informationColumn("table_catalog", "varchar", true),
+				informationColumn("table_schema", "varchar", true),
+				informationColumn("table_name", "varchar", true),
+				informationColumn("non_unique", "int", true),
+				informationColumn("index_schema", "varchar", true),
+				informationColumn("index_name", "varchar", false),
+				informationColumn("seq_in_index", "int", true),
+				informationColumn("column_name", "varchar", false),
+				informationColumn("collation", "varchar", false),
+				informationColumn("cardinality", "bigint", false),
+				informationColumn("sub_part", "bigint", false),
+				informationColumn("packed", "varchar", false),
+				informationColumn("nullable", "varchar", true),
+				informationColumn("index_type", "varchar", true),
+				informationColumn("comment", "varchar", true),
+				informationColumn("index_comment", "varchar", true),
+				informationColumn("is_visible", "varchar", true),
+				informationColumn("expression", "longtext", false),
+			),
+			informationTable("table_constraints",
+				informationColumn("constraint_catalog", "varchar", true),
+				informationColumn("constraint_schema", "varchar", true),
+				informationColumn("constraint_name", "varchar", false),
+				informationColumn("table_schema", "varchar", true),
+				informationColumn("table_name", "varchar", true),
+				informationColumn("constraint_type", "varchar", true),
+				informationColumn("enforced", "varchar", true),
+			),
+			informationTable("tables",
+				informationColumn("table_catalog", "varchar", true),
+				informationColumn("table_schema", "varchar", true),
+				informationColumn("table_name", "varchar", true),
+				informationColumn("table_type", "varchar", true),
+				informationColumn("engine", "varchar", false),
+				informationColumn("version", "int", false),
+				informationColumn("row_format", "varchar", false),
+				informationColumn("table_rows", "bigint", false),
+				informationColumn("avg_row_length", "bigint", false),
+				informationColumn("data_length", "bigint", false),
+				informationColumn("max_data_length", "bigint", false),
+				informationColumn("index_length", "bigint", false),
+				informationColumn("data_free", "bigint", false),
+				informationColumn("auto_increment", "bigint", false),
+				informationColumn("create_time", "timestamp", true),
+				informationColumn("update_time", "datetime", false),
+				informationColumn("check_time", "datetime", false),
+				informationColumn("table_collation", "varchar", false),
+				informationColumn("checksum", "bigint", false),
+				informationColumn("create_options", "varchar", false),
+				informationColumn("table_comment", "text", false),
+			),
+			informationTable("triggers",
+				informationColumn("trigger_catalog", "varchar", true),
+				informationColumn("trigger_schema", "varchar", true),
+				informationColumn("trigger_name", "varchar", true),
+				informationColumn("event_manipulation", "varchar", true),
+				informationColumn("event_object_catalog", "varchar", true),
+				informationColumn("event_object_schema", "varchar", true),
+				informationColumn("event_object_table", "varchar", true),
+				informationColumn("action_order", "int", true),
+				informationColumn("action_condition", "bigint", false),
+				informationColumn("action_statement", "longtext", true),
+				informationColumn("action_orientation", "varchar", true),
+				informationColumn("action_timing", "varchar", true),
+				informationColumn("action_reference_old_table", "bigint", false),
+				informationColumn("action_reference_new_table", "bigint", false),
+				informationColumn("action_reference_old_row", "varchar", true),
+				informationColumn("action_reference_new_row", "varchar", true),
+				informationColumn("created", "timestamp", true),
+				informationColumn("sql_mode", "varchar", true),
+				informationColumn("definer", "varchar", true),
+				informationColumn("character_set_client", "varchar", true),
+				informationColumn("collation_connection", "varchar", true),
+				informationColumn("database_collation", "varchar", true),
+			),
+			informationTable("user_privileges",
+				informationColumn("grantee", "varchar", true),
+				informationColumn("table_catalog", "varchar", true),
+				informationColumn("privilege_type", "varchar", true),
+				informationColumn("is_grantable", "varchar", true),
+			),
+			informationTable("views",
+				informationColumn("table_catalog", "varchar", true),
+				informationColumn("table_schema", "varchar", true),
+				informationColumn("table_name", "varchar", true),
+				informationColumn("view_definition", "longtext", false),
+				informationColumn("check_option", "varchar", true),
+				informationColumn("is_updatable", "varchar", true),
+				informationColumn("definer", "varchar", false),
+				informationColumn("security_type", "varchar", false),
+				informationColumn("character_set_client", "varchar", true),
+				informationColumn("collation_connection", "varchar", true),
+			),
+		},
+	}
+}
+
+func informationTable(name string, columns ...*catalog.Column) *catalog.Table {
+	return &catalog.Table{
+		Rel:     &ast.TableName{Schema: "information_schema", Name: name},
+		Columns: columns,
+	}
+}
+
+func informationColumn(name, typ string, notNull bool) *catalog.Column {
+	return &catalog.Column{
+		Name:      name,
+		Type:      ast.TypeName{Name: typ},
+		IsNotNull: notNull,
+	}
+}
@@ -0,0 +1,47 @@
+package dolphin
+
+import (
+	"github.com/stephenwithav/sqlc/pkg/sql/ast"
+	"github.com/stephenwithav/sqlc/pkg/sql/catalog"
+)
+
+func binaryOp(name, left, right, result string) *catalog.Operator {
+	return &catalog.Operator{
+		Name:       name,
+		Left:       &ast.TypeName{Name: left},
+		Right:      &ast.TypeName{Name: right},
+		ReturnType: &ast.TypeName{Name: result},
+	}
+}
+
+func prefixOp(name, right, result string) *catalog.Operator {
+	return &catalog.Operator{
+		Name:       name,
+		Right:      &ast.TypeName{Name: right},
+		ReturnType: &ast.TypeName{Name: result},
+	}
+}
+
+// builtinOperators returns the signatures of the built-in operators whose results
+// sqlc infers the types of. The numeric types are declared narrowest first.
+func builtinOperators() []*catalog.Operator {
+	var ops []*catalog.Operator
+	for _, t := range []string{"tinyint", "smallint", "mediumint", "int", "bigint", "decimal", "float", "double"} {
+		for _, name := range []string{"+", "-", "*", "/", "%"} {
+			ops = append(ops, binaryOp(name, t, t, t))
+		}
+		ops = append(ops, prefixOp("-", t, t))
+		switch t {
+		case "tinyint", "smallint", "mediumint", "int", "bigint":
+			for _, name := range []string{"&", "|", "^", "<<", ">>"} {
+				ops = append(ops, binaryOp(name, t, t, t))
+			}
+			ops = append(ops, prefixOp("~", t, t))
+		}
+	}
+	ops = append(ops,
+		binaryOp("->", "json", "any", "json"),
+		binaryOp("->>", "json", "any", "text"),
+	)
+	return ops
+}
@@ -53,22 +53,28 @@ func (p *Parser) Parse(r io.Reader) ([]ast.Statement, error) {
 	if err != nil {
 		return nil, err
 	}
-	stmtNodes, _, err := p.pingcap.Parse(string(blob), "", "")
+	src, aliases := hideRowAliases(string(blob))
+	stmtNodes, _, err := p.pingcap.Parse(src, "", "")
 	if err != nil {
 		return nil, normalizeErr(err)
 	}
 	var stmts []ast.Statement
 	for i := range stmtNodes {
+		// TODO: Attach the text directly to the ast.Statement node
+		text := stmtNodes[i].Text()
+		loc := strings.Index(src, text)
+
 		converter := &cc{}
+		for _, ra := range aliases {
+			if loc <= ra.loc && ra.loc < loc+len(text) {
+				converter.rowAlias = ra.alias
+			}
+		}
 		out := converter.convert(stmtNodes[i])
 		if _, ok := out.(*ast.TODO); ok {
 			continue
 		}
 
-		// TODO: Attach the text directly to the ast.Statement node
-		text := stmtNodes[i].Text()
-		loc := strings.Index(string(blob), text)
-
 		stmtLen := len(text)
 		if text[stmtLen-1] == ';' {
 			stmtLen -= 1 // Subtract one to remove semicolon
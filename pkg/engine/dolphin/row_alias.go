@@ -0,0 +1,193 @@
+package dolphin
+
+import (
+	"strings"
+
+	"github.com/stephenwithav/sqlc/pkg/sql/ast"
+)
+
+// rowAlias is the alias of the row proposed for insertion by an INSERT ...
+// ON DUPLICATE KEY UPDATE statement, found at loc in the source text.
+type rowAlias struct {
+	loc   int
+	alias *ast.Alias
+}
+
+// The TiDB parser has no row or column aliases of the form VALUES (...) AS
+// new(a, b) ON DUPLICATE KEY UPDATE. hideRowAliases blanks them out and
+// returns them with their locations.
+func hideRowAliases(sql string) (string, []rowAlias) {
+	var aliases []rowAlias
+	var blank [][2]int
+	var stmt []sqlToken
+	for _, tok := range scanTokens(sql) {
+		if !tok.is(";") {
+			stmt = append(stmt, tok)
+			continue
+		}
+		if ra, span, ok := insertRowAlias(stmt); ok {
+			aliases = append(aliases, ra)
+			blank = append(blank, span)
+		}
+		stmt = nil
+	}
+	if ra, span, ok := insertRowAlias(stmt); ok {
+		aliases = append(aliases, ra)
+		blank = append(blank, span)
+	}
+	if len(blank) == 0 {
+		return sql, nil
+	}
+	src := []byte(sql)
+	for _, span := range blank {
+		for i := span[0]; i < span[1]; i++ {
+			src[i] = ' '
+		}
+	}
+	return string(src), aliases
+}
+
+// insertRowAlias returns the row alias of an INSERT statement and the span
+// of its tokens. An alias directly before ON DUPLICATE KEY UPDATE belongs
+// to the row only when the statement inserts VALUES or SET assignments;
+// after a SELECT it names a derived table.
+func insertRowAlias(stmt []sqlToken) (rowAlias, [2]int, bool) {
+	if len(stmt) == 0 || !stmt[0].is("INSERT") {
+		return rowAlias{}, [2]int{}, false
+	}
+	var values bool
+	depth := 0
+	for k, tok := range stmt {
+		switch {
+		case tok.is("("):
+			depth++
+		case tok.is(")"):
+			depth--
+		case depth != 0:
+		case tok.is("VALUES"), tok.is("VALUE"), tok.is("SET"):
+			values = true
+		case tok.is("SELECT"), tok.is("TABLE"):
+			return rowAlias{}, [2]int{}, false
+		case tok.is("ON") && k+3 < len(stmt) && stmt[k+1].is("DUPLICATE") && stmt[k+2].is("KEY") && stmt[k+3].is("UPDATE"):
+			if !values {
+				return rowAlias{}, [2]int{}, false
+			}
+			return rowAliasBefore(stmt, k)
+		}
+	}
+	return rowAlias{}, [2]int{}, false
+}
+
+// rowAliasBefore parses AS alias [(col, ...)] ending before stmt[end].
+func rowAliasBefore(stmt []sqlToken, end int) (rowAlias, [2]int, bool) {
+	i := end - 1
+	var cols []string
+	if i >= 0 && stmt[i].is(")") {
+		open := i - 1
+		for open >= 0 && !stmt[open].is("(") {
+			open--
+		}
+		if open < 0 || (i-open)%2 != 0 {
+			return rowAlias{}, [2]int{}, false
+		}
+		for k := open + 1; k < i; k += 2 {
+			if !stmt[k].word || (k+1 < i && !stmt[k+1].is(",")) {
+				return rowAlias{}, [2]int{}, false
+			}
+			cols = append(cols, identifier(stmt[k].text))
+		}
+		i = open - 1
+	}
+	if i < 1 || !stmt[i].word || !stmt[i-1].is("AS") {
+		return rowAlias{}, [2]int{}, false
+	}
+	name := identifier(stmt[i].text)
+	alias := &ast.Alias{Aliasname: &name}
+	if len(cols) > 0 {
+		alias.Colnames = &ast.List{}
+		for _, col := range cols {
+			alias.Colnames.Items = append(alias.Colnames.Items, &ast.String{Str: col})
+		}
+	}
+	start := stmt[i-1].start
+	return rowAlias{loc: start, alias: alias}, [2]int{start, stmt[end-1].end}, true
+}
+
+// sqlToken is a word, quoted identifier or punctuation character of a MySQL
+// statement, spanning [start, end) of the source text. String literals and
+// comments are skipped.
+type sqlToken struct {
+	text       string
+	word       bool
+	quoted     bool
+	start, end int
+}
+
+// is reports whether the token is the given punctuation or unquoted keyword.
+func (t sqlToken) is(s string) bool {
+	return !t.quoted && strings.EqualFold(t.text, s)
+}
+
+func isWordByte(c byte) bool {
+	return c == '_' || c == '$' || c >= 0x80 ||
+		('a' <= c && c <= 'z') || ('A' <= c && c <= 'Z') || ('0' <= c && c <= '9')
+}
+
+// https://dev.mysql.com/doc/refman/8.0/en/comments.html
+func scanTokens(sql string) []sqlToken {
+	var toks []sqlToken
+	for i := 0; i < len(sql); {
+		c := sql[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == '\f':
+			i++
+		case c == '#' || (strings.HasPrefix(sql[i:], "--") && (i+2 == len(sql) || sql[i+2] <= ' ')):
+			if end := strings.IndexByte(sql[i:], '\n'); end >= 0 {
+				i += end + 1
+			} else {
+				i = len(sql)
+			}
+		case strings.HasPrefix(sql[i:], "/*"):
+			if end := strings.Index(sql[i+2:], "*/"); end >= 0 {
+				i += end + 4
+			} else {
+				i = len(sql)
+			}
+		case c == '\'' || c == '"':
+			i = skipQuoted(sql, i)
+		case c == '`':
+			end := skipQuoted(sql, i)
+			text := strings.ReplaceAll(strings.TrimSuffix(sql[i+1:end], "`"), "``", "`")
+			toks = append(toks, sqlToken{text: text, word: true, quoted: true, start: i, end: end})
+			i = end
+		case isWordByte(c):
+			end := i + 1
+			for end < len(sql) && isWordByte(sql[end]) {
+				end++
+			}
+			toks = append(toks, sqlToken{text: sql[i:end], word: true, start: i, end: end})
+			i = end
+		default:
+			toks = append(toks, sqlToken{text: sql[i : i+1], start: i, end: i + 1})
+			i++
+		}
+	}
+	return toks
+}
+
+// skipQuoted returns the end of the string or identifier quoted by sql[i].
+// Quotes are escaped by doubling them or, in strings, with a backslash.
+func skipQuoted(sql string, i int) int {
+	quote := sql[i]
+	for j := i + 1; j < len(sql); j++ {
+		switch {
+		case sql[j] == '\\' && quote != '`':
+			j++
+		case sql[j] == quote && j+1 < len(sql) && sql[j+1] == quote:
+			j++
+		case sql[j] == quote:
+			return j + 1
+		}
+	}
+	return len(sql)
+}
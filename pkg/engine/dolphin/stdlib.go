@@ -896,10 +896,11 @@ func defaultSchema(name string) *catalog.Schema {
 			Name: "FIRST_VALUE",
 			Args: []*catalog.Argument{
 				{
-					Type: &ast.TypeName{Name: "any"},
+					Type: &ast.TypeName{Name: "anyelement"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "any"},
+			ReturnType:         &ast.TypeName{Name: "anyelement"},
+			ReturnTypeNullable: true,
 		},
 		{
 			Name: "FLOOR",
@@ -1779,18 +1780,19 @@ func defaultSchema(name string) *catalog.Schema {
 			Name: "LAG",
 			Args: []*catalog.Argument{
 				{
-					Type: &ast.TypeName{Name: "any"},
+					Type: &ast.TypeName{Name: "anyelement"},
 				},
 				{
 					Type:       &ast.TypeName{Name: "int"},
 					HasDefault: true,
 				},
 				{
-					Type:       &ast.TypeName{Name: "any"},
+					Type:       &ast.TypeName{Name: "anyelement"},
 					HasDefault: true,
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "any"},
+			ReturnType:         &ast.TypeName{Name: "anyelement"},
+			ReturnTypeNullable: true,
 		},
 		{
 			Name: "LAST_DAY",
@@ -1819,10 +1821,11 @@ func defaultSchema(name string) *catalog.Schema {
 			Name: "LAST_VALUE",
 			Args: []*catalog.Argument{
 				{
-					Type: &ast.TypeName{Name: "any"},
+					Type: &ast.TypeName{Name: "anyelement"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "any"},
+			ReturnType:         &ast.TypeName{Name: "anyelement"},
+			ReturnTypeNullable: true,
 		},
 		{
 			Name: "LCASE",
@@ -1837,18 +1840,19 @@ func defaultSchema(name string) *catalog.Schema {
 			Name: "LEAD",
 			Args: []*catalog.Argument{
 				{
-					Type: &ast.TypeName{Name: "any"},
+					Type: &ast.TypeName{Name: "anyelement"},
 				},
 				{
 					Type:       &ast.TypeName{Name: "int"},
 					HasDefault: true,
 				},
 				{
-					Type:       &ast.TypeName{Name: "any"},
+					Type:       &ast.TypeName{Name: "anyelement"},
 					HasDefault: true,
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "any"},
+			ReturnType:         &ast.TypeName{Name: "anyelement"},
+			ReturnTypeNullable: true,
 		},
 		{
 			Name: "LEAST",
@@ -2134,6 +2138,20 @@ func defaultSchema(name string) *catalog.Schema {
 			},
 			ReturnType: &ast.TypeName{Name: "int"},
 		},
+		{
+			Name: "MATCH_AGAINST",
+			Args: []*catalog.Argument{
+				{
+					Name: "search",
+					Type: &ast.TypeName{Name: "text"},
+				},
+				{
+					Type: &ast.TypeName{Name: "any"},
+					Mode: ast.FuncParamVariadic,
+				},
+			},
+			ReturnType: &ast.TypeName{Name: "double"},
+		},
 		{
 			Name: "MAX",
 			Args: []*catalog.Argument{
@@ -2401,13 +2419,14 @@ func defaultSchema(name string) *catalog.Schema {
 			Name: "NTH_VALUE",
 			Args: []*catalog.Argument{
 				{
-					Type: &ast.TypeName{Name: "any"},
+					Type: &ast.TypeName{Name: "anyelement"},
 				},
 				{
 					Type: &ast.TypeName{Name: "int"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "any"},
+			ReturnType:         &ast.TypeName{Name: "anyelement"},
+			ReturnTypeNullable: true,
 		},
 		{
 			Name: "NTILE",
@@ -5373,6 +5392,21 @@ func defaultSchema(name string) *catalog.Schema {
 			},
 			ReturnType: &ast.TypeName{Name: "text"},
 		},
+		{
+			Name: "TRIM",
+			Args: []*catalog.Argument{
+				{
+					Type: &ast.TypeName{Name: "text"},
+				},
+				{
+					Type: &ast.TypeName{Name: "text"},
+				},
+				{
+					Type: &ast.TypeName{Name: "text"},
+				},
+			},
+			ReturnType: &ast.TypeName{Name: "text"},
+		},
 		{
 			Name: "TRUNCATE",
 			Args: []*catalog.Argument{
@@ -5522,10 +5556,11 @@ func defaultSchema(name string) *catalog.Schema {
 			Name: "VALUES",
 			Args: []*catalog.Argument{
 				{
-					Type: &ast.TypeName{Name: "any"},
+					Type: &ast.TypeName{Name: "anyelement"},
 				},
 			},
-			ReturnType: &ast.TypeName{Name: "any"},
+			ReturnType:         &ast.TypeName{Name: "anyelement"},
+			ReturnTypeNullable: true,
 		},
 		{
 			Name: "VARIANCE",
@@ -2,6 +2,7 @@ package dolphin
 
 import (
 	pcast "github.com/pingcap/tidb/parser/ast"
+	"github.com/pingcap/tidb/parser/types"
 
 	"github.com/stephenwithav/sqlc/pkg/sql/ast"
 )
@@ -85,6 +86,30 @@ func toList(node pcast.Node) *ast.List {
 	return &ast.List{Items: items}
 }
 
+// columnCollation returns the collation of a string column, which is the
+// table's unless the column names its own. It is nil when the default of
+// the server applies.
+func columnCollation(n *pcast.ColumnDef, tableCollation string) *ast.CollateClause {
+	if !types.HasCharset(n.Tp) {
+		return nil
+	}
+	collation := n.Tp.GetCollate()
+	for i := range n.Options {
+		if n.Options[i].Tp == pcast.ColumnOptionCollate {
+			collation = n.Options[i].StrValue
+		}
+	}
+	if collation == "" {
+		collation = tableCollation
+	}
+	if collation == "" {
+		return nil
+	}
+	return &ast.CollateClause{
+		Collname: &ast.List{Items: []ast.Node{NewIdentifier(collation)}},
+	}
+}
+
 func isNotNull(n *pcast.ColumnDef) bool {
 	for i := range n.Options {
 		if n.Options[i].Tp == pcast.ColumnOptionNotNull {
@@ -11,7 +11,9 @@ func toPointer(x int) *int {
 func NewCatalog() *catalog.Catalog {
 	c := catalog.New("public")
 	c.Schemas = append(c.Schemas, pgTemp())
-	c.Schemas = append(c.Schemas, genPGCatalog())
+	pg := genPGCatalog()
+	pg.Operators = pgOperators()
+	c.Schemas = append(c.Schemas, pg)
 	c.Schemas = append(c.Schemas, genInformationSchema())
 	c.SearchPath = []string{"pg_catalog"}
 	c.LoadExtension = loadExtension
@@ -31,6 +31,45 @@ func TestUpdateErrors(t *testing.T) {
 			`,
 			sqlerr.TypeExists("foo"),
 		},
+		{
+			`
+			CREATE TYPE foo AS ENUM ('bar');
+			CREATE DOMAIN foo AS text;
+			`,
+			sqlerr.TypeExists("foo"),
+		},
+		{
+			`
+			CREATE DOMAIN foo AS foo;
+			`,
+			sqlerr.TypeNotFound("foo"),
+		},
+		{
+			`
+			CREATE DOMAIN foo AS text CHECK (VALUE <> '');
+			ALTER DOMAIN foo ADD CONSTRAINT foo_check CHECK (length(VALUE) < 10);
+			`,
+			&sqlerr.Error{
+				Code:    "42710",
+				Message: `constraint "foo_check" for domain "foo" already exists`,
+			},
+		},
+		{
+			`
+			CREATE DOMAIN foo AS text;
+			ALTER DOMAIN foo DROP CONSTRAINT foo_check;
+			`,
+			&sqlerr.Error{
+				Code:    "42704",
+				Message: `constraint "foo_check" of domain "foo" does not exist`,
+			},
+		},
+		{
+			`
+			ALTER DOMAIN foo SET NOT NULL;
+			`,
+			sqlerr.TypeNotFound("foo"),
+		},
 		{
 			`
 			DROP TABLE foo;
@@ -86,6 +125,40 @@ func TestUpdateErrors(t *testing.T) {
 			`,
 			sqlerr.ColumnNotFound("foo", "bar"),
 		},
+		{
+			`
+			CREATE TABLE foo ();
+			CREATE INDEX ON foo (bar);
+			`,
+			sqlerr.ColumnNotFound("foo", "bar"),
+		},
+		{
+			`
+			CREATE TABLE foo (bar text);
+			CREATE INDEX foo_bar ON foo (bar);
+			CREATE INDEX foo_bar ON foo (bar);
+			`,
+			sqlerr.RelationExists("foo_bar"),
+		},
+		{
+			`
+			DROP INDEX foo_bar;
+			`,
+			sqlerr.RelationNotFound("foo_bar"),
+		},
+		{
+			`
+			CREATE SEQUENCE foo;
+			CREATE SEQUENCE foo;
+			`,
+			sqlerr.RelationExists("foo"),
+		},
+		{
+			`
+			DROP SEQUENCE foo;
+			`,
+			sqlerr.RelationNotFound("foo"),
+		},
 		{
 			`
 			CREATE SCHEMA foo;
@@ -0,0 +1,91 @@
+//go:build !windows
+// +build !windows
+
+package postgresql
+
+import (
+	"strings"
+	"testing"
+
+	nodes "github.com/pganalyze/pg_query_go/v2"
+
+	"github.com/stephenwithav/sqlc/pkg/sql/ast"
+)
+
+func TestDeparse(t *testing.T) {
+	p := NewParser()
+	for _, tc := range []struct {
+		in  string
+		out string
+	}{
+		{
+			"select id, name from authors where id = $1 limit 1",
+			"SELECT id, name FROM authors WHERE id = $1 LIMIT 1",
+		},
+		{
+			"SELECT DISTINCT ON (a.id) a.*, b.title AS t FROM authors a LEFT JOIN books b ON b.author_id = a.id ORDER BY a.id DESC NULLS LAST",
+			"SELECT DISTINCT ON (a.id) a.*, b.title AS t FROM authors AS a LEFT JOIN books AS b ON b.author_id = a.id ORDER BY a.id DESC NULLS LAST",
+		},
+		{
+			"select count(*) filter (where bio is not null), coalesce(max(id), 0) from authors group by name having count(*) > 1",
+			"SELECT count(*) FILTER (WHERE bio IS NOT NULL), COALESCE(max(id), 0) FROM authors GROUP BY name HAVING count(*) > 1",
+		},
+		{
+			"select * from authors where name like 'a%' and (id in (1, 2) or id between $1 and $2) and not deleted",
+			"SELECT * FROM authors WHERE name LIKE 'a%' AND (id IN (1, 2) OR id BETWEEN $1 AND $2) AND NOT deleted",
+		},
+		{
+			"select case when id > 1 then 'big' else 'small' end, id::text, sqlc.arg(name), @bio from authors",
+			"SELECT CASE WHEN id > 1 THEN 'big' ELSE 'small' END, id::text, sqlc.arg(name), @bio FROM authors",
+		},
+		{
+			"with recent as (select * from books where year > 2000) select * from recent union all select * from books where exists (select 1 from authors)",
+			"WITH recent AS (SELECT * FROM books WHERE year > 2000) SELECT * FROM recent UNION ALL SELECT * FROM books WHERE EXISTS (SELECT 1 FROM authors)",
+		},
+		{
+			"insert into authors (name, bio) values ($1, $2), ($3, null) on conflict (name) do update set bio = excluded.bio returning *",
+			"INSERT INTO authors (name, bio) VALUES ($1, $2), ($3, NULL) ON CONFLICT (name) DO UPDATE SET bio = excluded.bio RETURNING *",
+		},
+		{
+			"update authors set name = $1, bio = default where id = any($2::bigint[]) returning id",
+			"UPDATE authors SET name = $1, bio = DEFAULT WHERE id = ANY ($2::bigint[]) RETURNING id",
+		},
+		{
+			"delete from authors using books where books.author_id = authors.id and books.title = 'x''y'",
+			"DELETE FROM authors USING books WHERE books.author_id = authors.id AND books.title = 'x''y'",
+		},
+		{
+			`select "Order", "user" from public."Orders" for update skip locked`,
+			`SELECT "Order", "user" FROM public."Orders" FOR UPDATE SKIP LOCKED`,
+		},
+		{
+			"select row_number() over (partition by a order by b), greatest(a, b), current_date from t",
+			"SELECT row_number() OVER (PARTITION BY a ORDER BY b), GREATEST(a, b), CURRENT_DATE FROM t",
+		},
+	} {
+		stmts, err := p.Parse(strings.NewReader(tc.in))
+		if err != nil {
+			t.Fatal(err)
+		}
+		out, err := ast.Format(stmts[0].Raw.Stmt, p)
+		if err != nil {
+			t.Errorf("%s: %s", tc.in, err)
+			continue
+		}
+		if out != tc.out {
+			t.Errorf("deparse of %q\n got: %s\nwant: %s", tc.in, out, tc.out)
+		}
+		want, err := nodes.Fingerprint(tc.in)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := nodes.Fingerprint(out)
+		if err != nil {
+			t.Errorf("%s: %s", out, err)
+			continue
+		}
+		if got != want {
+			t.Errorf("deparse of %q changed its meaning: %s", tc.in, out)
+		}
+	}
+}
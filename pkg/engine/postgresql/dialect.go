@@ -0,0 +1,78 @@
+package postgresql
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/stephenwithav/sqlc/pkg/sql/ast"
+)
+
+// Names the parser gives to types spelled with SQL standard syntax
+var standardTypeNames = map[string]string{
+	"bool":        "boolean",
+	"bpchar":      "char",
+	"float4":      "real",
+	"float8":      "double precision",
+	"int2":        "smallint",
+	"int4":        "integer",
+	"int8":        "bigint",
+	"interval":    "interval",
+	"time":        "time",
+	"timestamp":   "timestamp",
+	"timestamptz": "timestamp with time zone",
+	"timetz":      "time with time zone",
+	"varbit":      "bit varying",
+}
+
+func (p *Parser) QuoteIdent(s string) string {
+	if p.IsReservedKeyword(s) || !isSimpleIdent(s) {
+		return `"` + strings.ReplaceAll(s, `"`, `""`) + `"`
+	}
+	return s
+}
+
+// isSimpleIdent reports whether s can be written without quotes: it is all
+// lower case, since the parser folds unquoted names.
+func isSimpleIdent(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i, r := range s {
+		switch {
+		case r == '_' || ('a' <= r && r <= 'z'):
+		case i > 0 && (r == '$' || ('0' <= r && r <= '9')):
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+func (p *Parser) TypeName(ns, name string) string {
+	if ns == "pg_catalog" {
+		if std, ok := standardTypeNames[name]; ok {
+			return std
+		}
+		return name
+	}
+	if ns != "" {
+		return p.QuoteIdent(ns) + "." + p.QuoteIdent(name)
+	}
+	return p.QuoteIdent(name)
+}
+
+func (p *Parser) Param(n int) string {
+	return fmt.Sprintf("$%d", n)
+}
+
+func (p *Parser) Cast(arg, typeName string) string {
+	return arg + "::" + typeName
+}
+
+func (p *Parser) OnDuplicateKey() bool {
+	return false
+}
+
+func (p *Parser) FuncCall(n *ast.FuncCall, args []string) (string, bool) {
+	return "", false
+}
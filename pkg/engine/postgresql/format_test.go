@@ -0,0 +1,33 @@
+//go:build !windows
+// +build !windows
+
+package postgresql
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseTree(t *testing.T) {
+	for _, tc := range []struct {
+		a, b string
+		same bool
+	}{
+		{"select id from authors where id = $1", "SELECT id\nFROM   authors WHERE id = $1", true},
+		{"SELECT 1", "SELECT 2", false},
+		{"SELECT * FROM authors WHERE name = 'a'", "SELECT * FROM authors WHERE name = 'b'", false},
+		{"SELECT * FROM authors LIMIT 10", "SELECT * FROM authors LIMIT 20", false},
+	} {
+		a, err := parseTree(tc.a)
+		if err != nil {
+			t.Fatal(err)
+		}
+		b, err := parseTree(tc.b)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := reflect.DeepEqual(a, b); got != tc.same {
+			t.Errorf("parseTree(%q) == parseTree(%q) is %v; want %v", tc.a, tc.b, got, tc.same)
+		}
+	}
+}
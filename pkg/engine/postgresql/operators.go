@@ -0,0 +1,121 @@
+package postgresql
+
+import (
+	"github.com/stephenwithav/sqlc/pkg/sql/ast"
+	"github.com/stephenwithav/sqlc/pkg/sql/catalog"
+)
+
+func binaryOp(name, left, right, result string) *catalog.Operator {
+	return &catalog.Operator{
+		Name:       name,
+		Left:       &ast.TypeName{Name: left},
+		Right:      &ast.TypeName{Name: right},
+		ReturnType: &ast.TypeName{Name: result},
+	}
+}
+
+func prefixOp(name, right, result string) *catalog.Operator {
+	return &catalog.Operator{
+		Name:       name,
+		Right:      &ast.TypeName{Name: right},
+		ReturnType: &ast.TypeName{Name: result},
+	}
+}
+
+// The numeric types, narrowest first
+var numericTypes = []string{"smallint", "integer", "bigint", "numeric", "real", "double precision"}
+
+// pgOperators returns the signatures of the operators in pg_operator whose
+// results sqlc infers the types of.
+func pgOperators() []*catalog.Operator {
+	var ops []*catalog.Operator
+	for _, t := range numericTypes {
+		for _, name := range []string{"+", "-", "*", "/"} {
+			ops = append(ops, binaryOp(name, t, t, t))
+		}
+		for _, name := range []string{"-", "+", "@"} {
+			ops = append(ops, prefixOp(name, t, t))
+		}
+		switch t {
+		case "smallint", "integer", "bigint":
+			for _, name := range []string{"%", "&", "|", "#"} {
+				ops = append(ops, binaryOp(name, t, t, t))
+			}
+			ops = append(ops,
+				binaryOp("<<", t, "integer", t),
+				binaryOp(">>", t, "integer", t),
+				prefixOp("~", t, t),
+			)
+		case "numeric":
+			ops = append(ops,
+				binaryOp("%", t, t, t),
+				binaryOp("^", t, t, t),
+			)
+		case "double precision":
+			ops = append(ops,
+				binaryOp("^", t, t, t),
+				prefixOp("|/", t, t),
+				prefixOp("||/", t, t),
+			)
+		}
+	}
+
+	ops = append(ops,
+		binaryOp("-", "date", "date", "integer"),
+		binaryOp("+", "date", "integer", "date"),
+		binaryOp("+", "integer", "date", "date"),
+		binaryOp("-", "date", "integer", "date"),
+		binaryOp("+", "date", "interval", "timestamp without time zone"),
+		binaryOp("+", "interval", "date", "timestamp without time zone"),
+		binaryOp("-", "date", "interval", "timestamp without time zone"),
+		binaryOp("+", "date", "time without time zone", "timestamp without time zone"),
+		binaryOp("+", "time without time zone", "date", "timestamp without time zone"),
+		binaryOp("+", "date", "time with time zone", "timestamp with time zone"),
+	)
+	for _, t := range []string{"timestamp without time zone", "timestamp with time zone", "time without time zone", "time with time zone"} {
+		ops = append(ops,
+			binaryOp("+", t, "interval", t),
+			binaryOp("+", "interval", t, t),
+			binaryOp("-", t, "interval", t),
+		)
+		if t != "time with time zone" {
+			ops = append(ops, binaryOp("-", t, t, "interval"))
+		}
+	}
+	ops = append(ops,
+		binaryOp("+", "interval", "interval", "interval"),
+		binaryOp("-", "interval", "interval", "interval"),
+		prefixOp("-", "interval", "interval"),
+		binaryOp("*", "interval", "double precision", "interval"),
+		binaryOp("*", "double precision", "interval", "interval"),
+		binaryOp("/", "interval", "double precision", "interval"),
+	)
+
+	ops = append(ops,
+		binaryOp("+", "inet", "bigint", "inet"),
+		binaryOp("+", "bigint", "inet", "inet"),
+		binaryOp("-", "inet", "bigint", "inet"),
+		binaryOp("-", "inet", "inet", "bigint"),
+		binaryOp("&", "inet", "inet", "inet"),
+		binaryOp("|", "inet", "inet", "inet"),
+		prefixOp("~", "inet", "inet"),
+	)
+
+	for _, t := range []string{"json", "jsonb"} {
+		ops = append(ops,
+			binaryOp("->", t, "text", t),
+			binaryOp("->", t, "integer", t),
+			binaryOp("->>", t, "text", "text"),
+			binaryOp("->>", t, "integer", "text"),
+			binaryOp("#>", t, "text[]", t),
+			binaryOp("#>>", t, "text[]", "text"),
+		)
+	}
+	ops = append(ops,
+		binaryOp("||", "jsonb", "jsonb", "jsonb"),
+		binaryOp("||", "text", "text", "text"),
+		binaryOp("||", "text", "anynonarray", "text"),
+		binaryOp("||", "anynonarray", "text", "text"),
+	)
+	return ops
+}
@@ -4,9 +4,12 @@
 package postgresql
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
+	"reflect"
+	"regexp"
 	"strings"
 
 	nodes "github.com/pganalyze/pg_query_go/v2"
@@ -184,6 +187,72 @@ func (p *Parser) Parse(r io.Reader) ([]ast.Statement, error) {
 	return stmts, nil
 }
 
+var namedParam = regexp.MustCompile(`\(@ ([A-Za-z_][A-Za-z0-9_]*)\)`)
+
+// Format deparses a single statement into its canonical form. The result is
+// rejected unless it has the same parse tree as the input.
+func (p *Parser) Format(sql string) (string, error) {
+	tree, err := nodes.Parse(sql)
+	if err != nil {
+		return "", err
+	}
+	if len(tree.Stmts) != 1 {
+		return "", fmt.Errorf("expected one statement; got %d", len(tree.Stmts))
+	}
+	out, err := nodes.Deparse(tree)
+	if err != nil {
+		return "", err
+	}
+	// The deparser prints sqlc's @name parameters as prefix operators
+	out = namedParam.ReplaceAllString(out, "@$1")
+
+	want, err := parseTree(sql)
+	if err != nil {
+		return "", err
+	}
+	got, err := parseTree(out)
+	if err != nil {
+		return "", err
+	}
+	if !reflect.DeepEqual(got, want) {
+		return "", fmt.Errorf("formatting changed the meaning of the statement")
+	}
+	return out, nil
+}
+
+// parseTree returns the parse tree of sql, constants included, without the
+// locations of its nodes.
+func parseTree(sql string) (interface{}, error) {
+	blob, err := nodes.ParseToJSON(sql)
+	if err != nil {
+		return nil, err
+	}
+	var tree interface{}
+	if err := json.Unmarshal([]byte(blob), &tree); err != nil {
+		return nil, err
+	}
+	stripLocations(tree)
+	return tree, nil
+}
+
+func stripLocations(tree interface{}) {
+	switch n := tree.(type) {
+	case map[string]interface{}:
+		for k, v := range n {
+			switch k {
+			case "location", "stmt_location", "stmt_len":
+				delete(n, k)
+			default:
+				stripLocations(v)
+			}
+		}
+	case []interface{}:
+		for _, v := range n {
+			stripLocations(v)
+		}
+	}
+}
+
 // https://www.postgresql.org/docs/current/sql-syntax-lexical.html#SQL-SYNTAX-COMMENTS
 func (p *Parser) CommentSyntax() metadata.CommentSyntax {
 	return metadata.CommentSyntax{
@@ -195,6 +264,44 @@ func (p *Parser) CommentSyntax() metadata.CommentSyntax {
 func translate(node *nodes.Node) (ast.Node, error) {
 	switch inner := node.Node.(type) {
 
+	case *nodes.Node_AlterDomainStmt:
+		n := inner.AlterDomainStmt
+		rel, err := parseRelationFromNodes(n.TypeName)
+		if err != nil {
+			return nil, fmt.Errorf("ALTER DOMAIN: %w", err)
+		}
+		stmt := &ast.AlterDomainStmt{
+			Subtype:   makeByte(n.Subtype),
+			Name:      makeString(n.Name),
+			MissingOk: n.MissingOk,
+			Domain:    rel.TypeName(),
+		}
+		switch stmt.Subtype {
+		case 'T':
+			// SET DEFAULT, or DROP DEFAULT without a Def
+			if n.Def != nil {
+				stmt.Def = convertNode(n.Def)
+			}
+		case 'N', 'O', 'X':
+			// DROP NOT NULL, SET NOT NULL and DROP CONSTRAINT
+		case 'C':
+			c, ok := n.Def.Node.(*nodes.Node_Constraint)
+			if !ok {
+				return nil, fmt.Errorf("ALTER DOMAIN: unexpected node type: %T", n.Def.Node)
+			}
+			switch c.Constraint.Contype {
+			case nodes.ConstrType_CONSTR_CHECK:
+				stmt.Def = convertConstraint(c.Constraint)
+			case nodes.ConstrType_CONSTR_NOTNULL:
+				stmt.Subtype = 'O'
+			default:
+				return nil, errSkip
+			}
+		default:
+			return nil, errSkip
+		}
+		return stmt, nil
+
 	case *nodes.Node_AlterEnumStmt:
 		n := inner.AlterEnumStmt
 		rel, err := parseRelationFromNodes(n.TypeName)
@@ -338,7 +445,7 @@ func translate(node *nodes.Node) (ast.Node, error) {
 				Comment: makeString(n.Comment),
 			}, nil
 
-		case nodes.ObjectType_OBJECT_TYPE:
+		case nodes.ObjectType_OBJECT_TYPE, nodes.ObjectType_OBJECT_DOMAIN:
 			rel, err := parseRelation(n.Object)
 			if err != nil {
 				return nil, err
@@ -397,16 +504,58 @@ func translate(node *nodes.Node) (ast.Node, error) {
 				if err != nil {
 					return nil, err
 				}
+				var collation *ast.CollateClause
+				if item.ColumnDef.CollClause != nil {
+					collation = &ast.CollateClause{Collname: &ast.List{}}
+					for _, part := range stringSliceFromNodes(item.ColumnDef.CollClause.Collname) {
+						collation.Collname.Items = append(collation.Collname.Items, &ast.String{Str: part})
+					}
+				}
 				create.Cols = append(create.Cols, &ast.ColumnDef{
-					Colname:   item.ColumnDef.Colname,
-					TypeName:  rel.TypeName(),
-					IsNotNull: isNotNull(item.ColumnDef) || primaryKey[item.ColumnDef.Colname],
-					IsArray:   isArray(item.ColumnDef.TypeName),
+					Colname:    item.ColumnDef.Colname,
+					TypeName:   rel.TypeName(),
+					IsNotNull:  isNotNull(item.ColumnDef) || primaryKey[item.ColumnDef.Colname],
+					IsArray:    isArray(item.ColumnDef.TypeName),
+					CollClause: collation,
 				})
 			}
 		}
 		return create, nil
 
+	case *nodes.Node_CreateDomainStmt:
+		n := inner.CreateDomainStmt
+		rel, err := parseRelationFromNodes(n.Domainname)
+		if err != nil {
+			return nil, fmt.Errorf("CREATE DOMAIN: %w", err)
+		}
+		base, err := parseRelationFromNodes(n.TypeName.Names)
+		if err != nil {
+			return nil, fmt.Errorf("CREATE DOMAIN: %w", err)
+		}
+		stmt := &ast.CreateDomainStmt{
+			Name:        rel.TypeName(),
+			TypeName:    base.TypeName(),
+			IsArray:     isArray(n.TypeName),
+			Constraints: &ast.List{},
+		}
+		for _, node := range n.Constraints {
+			c, ok := node.Node.(*nodes.Node_Constraint)
+			if !ok {
+				continue
+			}
+			switch c.Constraint.Contype {
+			case nodes.ConstrType_CONSTR_NOTNULL:
+				stmt.IsNotNull = true
+			case nodes.ConstrType_CONSTR_NULL:
+				stmt.IsNotNull = false
+			case nodes.ConstrType_CONSTR_DEFAULT:
+				stmt.Default = convertNode(c.Constraint.RawExpr)
+			case nodes.ConstrType_CONSTR_CHECK:
+				stmt.Constraints.Items = append(stmt.Constraints.Items, convertConstraint(c.Constraint))
+			}
+		}
+		return stmt, nil
+
 	case *nodes.Node_CreateEnumStmt:
 		n := inner.CreateEnumStmt
 		rel, err := parseRelationFromNodes(n.TypeName)
@@ -440,6 +589,7 @@ func translate(node *nodes.Node) (ast.Node, error) {
 				return nil, err
 			}
 			rt = rel.TypeName()
+			rt.Setof = n.ReturnType.Setof
 		}
 		stmt := &ast.CreateFunctionStmt{
 			Func:       fn.FuncName(),
@@ -514,6 +664,19 @@ func translate(node *nodes.Node) (ast.Node, error) {
 			}
 			return drop, nil
 
+		case nodes.ObjectType_OBJECT_INDEX:
+			drop := &ast.DropIndexStmt{
+				IfExists: n.MissingOk,
+			}
+			for _, obj := range n.Objects {
+				name, err := parseRelation(obj)
+				if err != nil {
+					return nil, fmt.Errorf("nodes.DropStmt: INDEX: %w", err)
+				}
+				drop.Indexes = append(drop.Indexes, name.TableName())
+			}
+			return drop, nil
+
 		case nodes.ObjectType_OBJECT_SCHEMA:
 			drop := &ast.DropSchemaStmt{
 				MissingOk: n.MissingOk,
@@ -527,6 +690,19 @@ func translate(node *nodes.Node) (ast.Node, error) {
 			}
 			return drop, nil
 
+		case nodes.ObjectType_OBJECT_SEQUENCE:
+			drop := &ast.DropSequenceStmt{
+				IfExists: n.MissingOk,
+			}
+			for _, obj := range n.Objects {
+				name, err := parseRelation(obj)
+				if err != nil {
+					return nil, fmt.Errorf("nodes.DropStmt: SEQUENCE: %w", err)
+				}
+				drop.Sequences = append(drop.Sequences, name.TableName())
+			}
+			return drop, nil
+
 		case nodes.ObjectType_OBJECT_TABLE, nodes.ObjectType_OBJECT_VIEW, nodes.ObjectType_OBJECT_MATVIEW:
 			drop := &ast.DropTableStmt{
 				IfExists: n.MissingOk,
@@ -540,7 +716,7 @@ func translate(node *nodes.Node) (ast.Node, error) {
 			}
 			return drop, nil
 
-		case nodes.ObjectType_OBJECT_TYPE:
+		case nodes.ObjectType_OBJECT_TYPE, nodes.ObjectType_OBJECT_DOMAIN:
 			drop := &ast.DropTypeStmt{
 				IfExists: n.MissingOk,
 			}
@@ -575,7 +751,7 @@ func translate(node *nodes.Node) (ast.Node, error) {
 				NewName: makeString(n.Newname),
 			}, nil
 
-		case nodes.ObjectType_OBJECT_TYPE:
+		case nodes.ObjectType_OBJECT_TYPE, nodes.ObjectType_OBJECT_DOMAIN:
 			rel, err := parseRelation(n.Object)
 			if err != nil {
 				return nil, fmt.Errorf("nodes.RenameStmt: TYPE: %w", err)
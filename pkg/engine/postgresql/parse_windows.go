@@ -22,6 +22,10 @@ func (p *Parser) Parse(r io.Reader) ([]ast.Statement, error) {
 	return nil, errors.New("the PostgreSQL engine does not support Windows")
 }
 
+func (p *Parser) Format(sql string) (string, error) {
+	return "", errors.New("the PostgreSQL engine does not support Windows")
+}
+
 // https://www.postgresql.org/docs/current/sql-syntax-lexical.html#SQL-SYNTAX-COMMENTS
 func (p *Parser) CommentSyntax() metadata.CommentSyntax {
 	return metadata.CommentSyntax{
@@ -13,6 +13,14 @@ func NewCatalog() *catalog.Catalog {
 	}
 }
 
+func defaultSchema(name string) *catalog.Schema {
+	s := &catalog.Schema{Name: name}
+	s.Funcs = append(s.Funcs, funcsStdlib...)
+	s.Funcs = append(s.Funcs, funcsExtension...)
+	s.Operators = builtinOperators()
+	return s
+}
+
 func newTestCatalog() *catalog.Catalog {
 	return catalog.New("main")
 }
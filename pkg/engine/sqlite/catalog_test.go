@@ -230,6 +230,138 @@ func TestUpdate(t *testing.T) {
 				},
 			},
 		},
+		{
+			`
+			CREATE TABLE foo (id integer PRIMARY KEY, bar int PRIMARY KEY, baz);
+			`,
+			&catalog.Schema{
+				Name: "main",
+				Tables: []*catalog.Table{
+					{
+						Rel: &ast.TableName{Name: "foo"},
+						Columns: []*catalog.Column{
+							{
+								Name:      "id",
+								Type:      ast.TypeName{Name: "integer"},
+								IsNotNull: true,
+							},
+							{
+								Name:      "bar",
+								Type:      ast.TypeName{Name: "int"},
+								IsNotNull: true,
+							},
+							{
+								Name: "baz",
+								Type: ast.TypeName{Name: "any"},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			`
+			CREATE TABLE foo (
+				bar text,
+				baz integer GENERATED ALWAYS AS (length(bar)) STORED,
+				PRIMARY KEY (bar)
+			) WITHOUT ROWID, STRICT;
+			`,
+			&catalog.Schema{
+				Name: "main",
+				Tables: []*catalog.Table{
+					{
+						Rel:    &ast.TableName{Name: "foo"},
+						Strict: true,
+						Columns: []*catalog.Column{
+							{
+								Name:      "bar",
+								Type:      ast.TypeName{Name: "text"},
+								IsNotNull: true,
+							},
+							{
+								Name:        "baz",
+								Type:        ast.TypeName{Name: "integer"},
+								IsGenerated: true,
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			`
+			CREATE VIRTUAL TABLE docs USING fts5(title, body UNINDEXED, tokenize = 'porter');
+			`,
+			&catalog.Schema{
+				Name: "main",
+				Tables: []*catalog.Table{
+					{
+						Rel: &ast.TableName{Name: "docs"},
+						Columns: []*catalog.Column{
+							{
+								Name: "title",
+								Type: ast.TypeName{Name: "text"},
+							},
+							{
+								Name: "body",
+								Type: ast.TypeName{Name: "text"},
+							},
+							{
+								Name:      "rowid",
+								Type:      ast.TypeName{Name: "integer"},
+								IsNotNull: true,
+								IsHidden:  true,
+							},
+							{
+								Name:     "docs",
+								Type:     ast.TypeName{Name: "text"},
+								IsHidden: true,
+							},
+							{
+								Name:     "rank",
+								Type:     ast.TypeName{Name: "real"},
+								IsHidden: true,
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			`
+			CREATE VIRTUAL TABLE boxes USING rtree_i32(id, x0, x1, +label);
+			`,
+			&catalog.Schema{
+				Name: "main",
+				Tables: []*catalog.Table{
+					{
+						Rel: &ast.TableName{Name: "boxes"},
+						Columns: []*catalog.Column{
+							{
+								Name:      "id",
+								Type:      ast.TypeName{Name: "integer"},
+								IsNotNull: true,
+							},
+							{
+								Name:      "x0",
+								Type:      ast.TypeName{Name: "integer"},
+								IsNotNull: true,
+							},
+							{
+								Name:      "x1",
+								Type:      ast.TypeName{Name: "integer"},
+								IsNotNull: true,
+							},
+							{
+								Name: "label",
+								Type: ast.TypeName{Name: "any"},
+							},
+						},
+					},
+				},
+			},
+		},
 	} {
 		test := tc
 		t.Run(strconv.Itoa(i), func(t *testing.T) {
@@ -267,3 +399,36 @@ func TestUpdate(t *testing.T) {
 		})
 	}
 }
+
+func TestNullablePrimaryKeys(t *testing.T) {
+	p := NewParser()
+	p.NullablePrimaryKeys = true
+	stmts, err := p.Parse(strings.NewReader(`
+		CREATE TABLE alias (id integer PRIMARY KEY);
+		CREATE TABLE nullable (id int PRIMARY KEY);
+		CREATE TABLE declared (id text NOT NULL PRIMARY KEY);
+		CREATE TABLE clustered (id int PRIMARY KEY) WITHOUT ROWID;
+	`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := newTestCatalog()
+	if err := c.Build(stmts); err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]bool{
+		"alias":     true,
+		"nullable":  false,
+		"declared":  true,
+		"clustered": true,
+	}
+	for _, schema := range c.Schemas {
+		for _, table := range schema.Tables {
+			for _, col := range table.Columns {
+				if col.IsNotNull != want[table.Rel.Name] {
+					t.Errorf("%s.%s: IsNotNull is %v", table.Rel.Name, col.Name, col.IsNotNull)
+				}
+			}
+		}
+	}
+}
@@ -14,6 +14,11 @@ import (
 
 type cc struct {
 	paramCount int
+	paramFuncs map[int]string
+	strict     map[int]bool
+	jsonOps    map[int]string
+
+	nullablePKs bool
 }
 
 type node interface {
@@ -27,6 +32,14 @@ func todo(n node) *ast.TODO {
 	return &ast.TODO{}
 }
 
+// unquote returns the value of a string literal.
+func unquote(s string) string {
+	if len(s) >= 2 && s[0] == '\'' && s[len(s)-1] == '\'' {
+		return strings.ReplaceAll(s[1:len(s)-1], "''", "'")
+	}
+	return s
+}
+
 func identifier(id string) string {
 	return strings.ToLower(id)
 }
@@ -67,13 +80,7 @@ func (c *cc) convertAlter_table_stmtContext(n *parser.Alter_table_stmtContext) a
 			stmt.Cmds.Items = append(stmt.Cmds.Items, &ast.AlterTableCmd{
 				Name:    &name,
 				Subtype: ast.AT_AddColumn,
-				Def: &ast.ColumnDef{
-					Colname: name,
-					TypeName: &ast.TypeName{
-						Name: def.Type_name().GetText(),
-					},
-					IsNotNull: hasNotNullConstraint(def.AllColumn_constraint()),
-				},
+				Def:     columnDef(def),
 			})
 			return stmt
 		}
@@ -107,14 +114,147 @@ func (c *cc) convertCreate_table_stmtContext(n *parser.Create_table_stmtContext)
 		Name:        parseTableName(n),
 		IfNotExists: n.EXISTS_() != nil,
 	}
+	if n.CLOSE_PAR() != nil {
+		stmt.Strict = c.strict[n.CLOSE_PAR().GetSymbol().GetStart()]
+	}
+	// With nullablePKs, primary key columns may hold NULL except in WITHOUT
+	// ROWID and STRICT tables and for the INTEGER PRIMARY KEY alias of the
+	// rowid
+	notNull := !c.nullablePKs || n.WITHOUT_() != nil || stmt.Strict
 	for _, idef := range n.AllColumn_def() {
 		if def, ok := idef.(*parser.Column_defContext); ok {
+			col := columnDef(def)
+			if pk, desc := hasPrimaryKeyConstraint(def.AllColumn_constraint()); pk {
+				if notNull || (isRowID(col) && !desc) {
+					col.IsNotNull = true
+				}
+			}
+			stmt.Cols = append(stmt.Cols, col)
+		}
+	}
+	for _, icon := range n.AllTable_constraint() {
+		con, ok := icon.(*parser.Table_constraintContext)
+		if !ok || con.PRIMARY_() == nil {
+			continue
+		}
+		var keys []*ast.ColumnDef
+		for _, icol := range con.AllIndexed_column() {
+			if col, ok := icol.(*parser.Indexed_columnContext); ok && col.Column_name() != nil {
+				name := identifier(col.Column_name().GetText())
+				for _, def := range stmt.Cols {
+					if def.Colname == name {
+						keys = append(keys, def)
+					}
+				}
+			}
+		}
+		for _, def := range keys {
+			if notNull || (len(keys) == 1 && isRowID(def)) {
+				def.IsNotNull = true
+			}
+		}
+	}
+	return stmt
+}
+
+// isRowID reports whether a primary key column is an alias for the rowid.
+func isRowID(col *ast.ColumnDef) bool {
+	return strings.EqualFold(col.TypeName.Name, "integer")
+}
+
+// The columns of a virtual table are given by its module. Arguments which
+// aren't column names, such as tokenize = 'porter', are options.
+func (c *cc) convertCreate_virtual_table_stmtContext(n *parser.Create_virtual_table_stmtContext) ast.Node {
+	stmt := &ast.CreateTableStmt{
+		Name:        parseTableName(n),
+		IfNotExists: n.EXISTS_() != nil,
+	}
+	type moduleArg struct {
+		def *ast.ColumnDef
+		// aux is set for the auxiliary columns of an R*Tree, which are
+		// prefixed with +
+		aux bool
+	}
+	var args []moduleArg
+	for _, iarg := range n.AllModule_argument() {
+		arg, ok := iarg.(*parser.Module_argumentContext)
+		if !ok {
+			continue
+		}
+		if def, ok := arg.Column_def().(*parser.Column_defContext); ok {
+			args = append(args, moduleArg{def: columnDef(def)})
+			continue
+		}
+		switch expr := arg.Expr().(type) {
+		case *parser.Expr_qualified_column_nameContext:
+			if expr.Table_name() == nil {
+				args = append(args, moduleArg{def: &ast.ColumnDef{
+					Colname:  identifier(expr.Column_name().GetText()),
+					TypeName: &ast.TypeName{Name: "any"},
+				}})
+			}
+		case *parser.Expr_unaryContext:
+			col, ok := expr.Expr().(*parser.Expr_qualified_column_nameContext)
+			if ok && expr.Unary_operator().GetText() == "+" && col.Table_name() == nil {
+				args = append(args, moduleArg{def: &ast.ColumnDef{
+					Colname:  identifier(col.Column_name().GetText()),
+					TypeName: &ast.TypeName{Name: "any"},
+				}, aux: true})
+			}
+		}
+	}
+
+	switch module := strings.ToLower(n.Module_name().GetText()); module {
+	case "fts3", "fts4", "fts5":
+		// Full-text tables hold text. The hidden column named after the
+		// table is the left operand of MATCH, and the rowid is implicit.
+		for _, arg := range args {
 			stmt.Cols = append(stmt.Cols, &ast.ColumnDef{
-				Colname:   identifier(def.Column_name().GetText()),
-				IsNotNull: hasNotNullConstraint(def.AllColumn_constraint()),
-				TypeName:  &ast.TypeName{Name: def.Type_name().GetText()},
+				Colname:  arg.def.Colname,
+				TypeName: &ast.TypeName{Name: "text"},
 			})
 		}
+		stmt.Cols = append(stmt.Cols,
+			hiddenColumn("rowid", "integer", true),
+			hiddenColumn(identifier(stmt.Name.Name), "text", false),
+		)
+		if module == "fts5" {
+			stmt.Cols = append(stmt.Cols, hiddenColumn("rank", "real", false))
+		} else {
+			stmt.Cols = append(stmt.Cols, hiddenColumn("docid", "integer", true))
+		}
+
+	case "rtree", "rtree_i32":
+		// The integer key is followed by pairs of coordinates and any
+		// auxiliary columns
+		coord := "real"
+		if module == "rtree_i32" {
+			coord = "integer"
+		}
+		for i, arg := range args {
+			col := arg.def
+			switch {
+			case arg.aux:
+				col.IsNotNull = false
+			case i == 0:
+				col.TypeName = &ast.TypeName{Name: "integer"}
+				col.IsNotNull = true
+			default:
+				col.TypeName = &ast.TypeName{Name: coord}
+				col.IsNotNull = true
+			}
+			stmt.Cols = append(stmt.Cols, col)
+		}
+
+	default:
+		// The columns of other modules are only known when they are given
+		// as arguments
+		if len(args) == 0 {
+			return todo(n)
+		}
+		for _, arg := range args {
+			stmt.Cols = append(stmt.Cols, arg.def)
+		}
 	}
 	return stmt
 }
@@ -141,6 +281,7 @@ func (c *cc) convertCreate_view_stmtContext(n *parser.Create_view_stmtContext) a
 }
 
 func (c *cc) convertDelete_stmtContext(n *parser.Delete_stmtContext) ast.Node {
+	with := c.convertWith_clauseContext(n.With_clause())
 	if qualifiedName, ok := n.Qualified_table_name().(*parser.Qualified_table_nameContext); ok {
 
 		tableName := qualifiedName.Table_name().GetText()
@@ -159,14 +300,15 @@ func (c *cc) convertDelete_stmtContext(n *parser.Delete_stmtContext) ast.Node {
 		}
 
 		delete := &ast.DeleteStmt{
-			Relation:      relation,
-			ReturningList: c.convertReturning_caluseContext(n.Returning_clause()),
-			WithClause:    nil,
+			Relation:   relation,
+			WithClause: with,
 		}
 
 		if n.WHERE_() != nil && n.Expr() != nil {
 			delete.WhereClause = c.convert(n.Expr())
 		}
+		// RETURNING follows WHERE, and parameters are numbered in order
+		delete.ReturningList = c.convertReturning_caluseContext(n.Returning_clause())
 
 		return delete
 	}
@@ -194,6 +336,7 @@ func (c *cc) convertDrop_stmtContext(n *parser.Drop_stmtContext) ast.Node {
 func (c *cc) convertFuncContext(n *parser.Expr_functionContext) ast.Node {
 	if name, ok := n.Function_name().(*parser.Function_nameContext); ok {
 		funcName := strings.ToLower(name.GetText())
+		loc := n.GetStart().GetStart()
 
 		var argNodes []ast.Node
 		for _, exp := range n.AllExpr() {
@@ -201,6 +344,24 @@ func (c *cc) convertFuncContext(n *parser.Expr_functionContext) ast.Node {
 		}
 		args := &ast.List{Items: argNodes}
 
+		if fn, ok := c.paramFuncs[loc]; ok {
+			return &ast.FuncCall{
+				Func: &ast.FuncName{
+					Schema: "sqlc",
+					Name:   fn,
+				},
+				Funcname: &ast.List{
+					Items: []ast.Node{
+						NewIdentifer("sqlc"),
+						NewIdentifer(fn),
+					},
+				},
+				Args:     args,
+				AggOrder: &ast.List{},
+				Location: loc,
+			}
+		}
+
 		if funcName == "coalesce" {
 			return &ast.CoalesceExpr{
 				Args: args,
@@ -219,6 +380,9 @@ func (c *cc) convertFuncContext(n *parser.Expr_functionContext) ast.Node {
 				Args:        args,
 				AggOrder:    &ast.List{},
 				AggDistinct: n.DISTINCT_() != nil,
+				AggFilter:   c.convertFilter_clauseContext(n.Filter_clause()),
+				Over:        c.convertOver_clauseContext(n.Over_clause()),
+				Location:    loc,
 			}
 		}
 	}
@@ -226,6 +390,139 @@ func (c *cc) convertFuncContext(n *parser.Expr_functionContext) ast.Node {
 	return todo(n)
 }
 
+func (c *cc) convertFilter_clauseContext(n parser.IFilter_clauseContext) ast.Node {
+	filter, ok := n.(*parser.Filter_clauseContext)
+	if !ok {
+		return nil
+	}
+	return c.convert(filter.Expr())
+}
+
+func (c *cc) convertOver_clauseContext(n parser.IOver_clauseContext) *ast.WindowDef {
+	over, ok := n.(*parser.Over_clauseContext)
+	if !ok {
+		return nil
+	}
+	if name := over.Window_name(); name != nil {
+		// OVER w refers to a window of the WINDOW clause
+		windowName := identifier(name.GetText())
+		return &ast.WindowDef{
+			Name:            &windowName,
+			PartitionClause: &ast.List{},
+			OrderClause:     &ast.List{},
+			FrameOptions:    ast.FrameOptionRange | ast.FrameOptionStartUnboundedPreceding | ast.FrameOptionEndCurrentRow,
+			Location:        over.GetStart().GetStart(),
+		}
+	}
+	return c.convertWindow(over)
+}
+
+// A window is defined in the same way by an OVER clause and by the WINDOW
+// clause of a SELECT statement.
+type windowContext interface {
+	node
+	GetStart() antlr.Token
+	Base_window_name() parser.IBase_window_nameContext
+	AllExpr() []parser.IExprContext
+	AllOrdering_term() []parser.IOrdering_termContext
+	Frame_spec() parser.IFrame_specContext
+}
+
+func (c *cc) convertWindow(n windowContext) *ast.WindowDef {
+	def := &ast.WindowDef{
+		PartitionClause: &ast.List{},
+		OrderClause:     &ast.List{},
+		FrameOptions:    ast.FrameOptionRange | ast.FrameOptionStartUnboundedPreceding | ast.FrameOptionEndCurrentRow,
+		Location:        n.GetStart().GetStart(),
+	}
+	if base := n.Base_window_name(); base != nil {
+		ref := identifier(base.GetText())
+		def.Refname = &ref
+	}
+	for _, expr := range n.AllExpr() {
+		def.PartitionClause.Items = append(def.PartitionClause.Items, c.convert(expr))
+	}
+	for _, iterm := range n.AllOrdering_term() {
+		term, ok := iterm.(*parser.Ordering_termContext)
+		if !ok {
+			continue
+		}
+		def.OrderClause.Items = append(def.OrderClause.Items, c.convertOrdering_termContext(term))
+	}
+	if spec, ok := n.Frame_spec().(*parser.Frame_specContext); ok {
+		c.convertFrame_specContext(spec, def)
+	}
+	return def
+}
+
+func (c *cc) convertFrame_specContext(n *parser.Frame_specContext, def *ast.WindowDef) {
+	frame, ok := n.Frame_clause().(*parser.Frame_clauseContext)
+	if !ok {
+		return
+	}
+	opts := ast.FrameOptionNonDefault
+	switch {
+	case frame.ROWS_() != nil:
+		opts |= ast.FrameOptionRows
+	case frame.GROUPS_() != nil:
+		opts |= ast.FrameOptionGroups
+	default:
+		opts |= ast.FrameOptionRange
+	}
+
+	if single, ok := frame.Frame_single().(*parser.Frame_singleContext); ok {
+		switch {
+		case single.UNBOUNDED_() != nil:
+			opts |= ast.FrameOptionStartUnboundedPreceding
+		case single.CURRENT_() != nil:
+			opts |= ast.FrameOptionStartCurrentRow
+		default:
+			opts |= ast.FrameOptionStartOffsetPreceding
+			def.StartOffset = c.convert(single.Expr())
+		}
+		opts |= ast.FrameOptionEndCurrentRow
+	}
+	if left, ok := frame.Frame_left().(*parser.Frame_leftContext); ok {
+		opts |= ast.FrameOptionBetween
+		switch {
+		case left.UNBOUNDED_() != nil:
+			opts |= ast.FrameOptionStartUnboundedPreceding
+		case left.CURRENT_() != nil:
+			opts |= ast.FrameOptionStartCurrentRow
+		case left.PRECEDING_() != nil:
+			opts |= ast.FrameOptionStartOffsetPreceding
+			def.StartOffset = c.convert(left.Expr())
+		default:
+			opts |= ast.FrameOptionStartOffsetFollowing
+			def.StartOffset = c.convert(left.Expr())
+		}
+	}
+	if right, ok := frame.Frame_right().(*parser.Frame_rightContext); ok {
+		switch {
+		case right.UNBOUNDED_() != nil:
+			opts |= ast.FrameOptionEndUnboundedFollowing
+		case right.CURRENT_() != nil:
+			opts |= ast.FrameOptionEndCurrentRow
+		case right.PRECEDING_() != nil:
+			opts |= ast.FrameOptionEndOffsetPreceding
+			def.EndOffset = c.convert(right.Expr())
+		default:
+			opts |= ast.FrameOptionEndOffsetFollowing
+			def.EndOffset = c.convert(right.Expr())
+		}
+	}
+
+	switch {
+	case n.EXCLUDE_() != nil && n.CURRENT_() != nil:
+		opts |= ast.FrameOptionExcludeCurrentRow
+	case n.EXCLUDE_() != nil && n.GROUP_() != nil:
+		opts |= ast.FrameOptionExcludeGroup
+	case n.EXCLUDE_() != nil && n.TIES_() != nil:
+		opts |= ast.FrameOptionExcludeTies
+	}
+	def.FrameOptions = opts
+}
+
 func (c *cc) convertExprContext(n *parser.ExprContext) ast.Node {
 	return &ast.Expr{}
 }
@@ -252,73 +549,319 @@ func (c *cc) convertColumnNameExpr(n *parser.Expr_qualified_column_nameContext)
 	}
 }
 
+// operators maps the tokens of binary operators to the names of the
+// operators in the tree
+var operators = map[int]string{
+	parser.SQLiteParserPIPE2:   "||",
+	parser.SQLiteParserSTAR:    "*",
+	parser.SQLiteParserDIV:     "/",
+	parser.SQLiteParserMOD:     "%",
+	parser.SQLiteParserPLUS:    "+",
+	parser.SQLiteParserMINUS:   "-",
+	parser.SQLiteParserLT2:     "<<",
+	parser.SQLiteParserGT2:     ">>",
+	parser.SQLiteParserAMP:     "&",
+	parser.SQLiteParserPIPE:    "|",
+	parser.SQLiteParserLT:      "<",
+	parser.SQLiteParserLT_EQ:   "<=",
+	parser.SQLiteParserGT:      ">",
+	parser.SQLiteParserGT_EQ:   ">=",
+	parser.SQLiteParserASSIGN:  "=",
+	parser.SQLiteParserEQ:      "=",
+	parser.SQLiteParserNOT_EQ1: "!=",
+	parser.SQLiteParserNOT_EQ2: "<>",
+	parser.SQLiteParserGLOB_:   "glob",
+	parser.SQLiteParserMATCH_:  "match",
+	parser.SQLiteParserREGEXP_: "regexp",
+}
+
+// operator returns the name of the first operator token among the children
+// of an expression.
+func operator(n antlr.Tree) string {
+	for _, child := range n.GetChildren() {
+		if term, ok := child.(antlr.TerminalNode); ok {
+			if op, ok := operators[term.GetSymbol().GetTokenType()]; ok {
+				return op
+			}
+		}
+	}
+	return ""
+}
+
 func (c *cc) convertComparison(n *parser.Expr_comparisonContext) ast.Node {
-	aExpr := &ast.A_Expr{
-		Name: &ast.List{
-			Items: []ast.Node{
-				&ast.String{Str: "="}, // TODO: add actual comparison
+	loc := n.GetStart().GetStart()
+	lexpr := c.convert(n.Expr(0))
+	rexpr := c.convert(n.Expr(1))
+	if n.ESCAPE_() != nil {
+		rexpr = &ast.FuncCall{
+			Func: &ast.FuncName{Name: "like_escape"},
+			Funcname: &ast.List{
+				Items: []ast.Node{NewIdentifer("like_escape")},
+			},
+			Args: &ast.List{
+				Items: []ast.Node{rexpr, c.convert(n.Expr(2))},
+			},
+			AggOrder: &ast.List{},
+			Location: n.ESCAPE_().GetSymbol().GetStart(),
+		}
+	}
+
+	switch {
+	case n.IS_() != nil:
+		// The grammar parses the NOT of x IS NOT y as a unary operator on y
+		not := n.NOT_() != nil
+		if _, ok := n.Expr(1).(*parser.Expr_unaryContext); ok && !not {
+			if b, ok := rexpr.(*ast.BoolExpr); ok && b.Boolop == ast.BoolExprTypeNot {
+				not = true
+				rexpr = b.Args.Items[0]
+			}
+		}
+		// IS compares values the way IS NOT DISTINCT FROM does
+		if isNullConst(rexpr) {
+			test := &ast.NullTest{
+				Arg:          lexpr,
+				Nulltesttype: ast.NullTestTypeIsNull,
+				Location:     loc,
+			}
+			if not {
+				test.Nulltesttype = ast.NullTestTypeIsNotNull
+			}
+			return test
+		}
+		kind := ast.A_Expr_Kind_NOT_DISTINCT
+		if not {
+			kind = ast.A_Expr_Kind_DISTINCT
+		}
+		return &ast.A_Expr{
+			Kind: kind,
+			Name: &ast.List{
+				Items: []ast.Node{&ast.String{Str: "="}},
 			},
+			Lexpr:    lexpr,
+			Rexpr:    rexpr,
+			Location: loc,
+		}
+
+	case n.IN_() != nil:
+		// The parentheses of x IN (a, b) and x IN (SELECT ...) are parsed
+		// as a row or a subquery
+		switch r := rexpr.(type) {
+		case *ast.RowExpr:
+			return &ast.In{
+				Expr:     lexpr,
+				List:     r.Args.Items,
+				Location: loc,
+			}
+		case *ast.SubLink:
+			if r.SubLinkType == ast.EXPR_SUBLINK {
+				r.SubLinkType = ast.ANY_SUBLINK
+				r.Testexpr = lexpr
+				return r
+			}
+		}
+		return &ast.In{
+			Expr:     lexpr,
+			List:     []ast.Node{rexpr},
+			Location: loc,
+		}
+
+	case n.LIKE_() != nil:
+		op := "~~"
+		if n.NOT_() != nil {
+			op = "!~~"
+		}
+		return &ast.A_Expr{
+			Kind: ast.A_Expr_Kind_LIKE,
+			Name: &ast.List{
+				Items: []ast.Node{&ast.String{Str: op}},
+			},
+			Lexpr:    lexpr,
+			Rexpr:    rexpr,
+			Location: loc,
+		}
+	}
+
+	var expr ast.Node = &ast.A_Expr{
+		Kind: ast.A_Expr_Kind_OP,
+		Name: &ast.List{
+			Items: []ast.Node{&ast.String{Str: operator(n)}},
 		},
-		Lexpr: c.convert(n.Expr(0)),
-		Rexpr: c.convert(n.Expr(1)),
+		Lexpr:    lexpr,
+		Rexpr:    rexpr,
+		Location: loc,
+	}
+	if n.NOT_() != nil {
+		// NOT GLOB, NOT MATCH and NOT REGEXP
+		expr = &ast.BoolExpr{
+			Boolop:   ast.BoolExprTypeNot,
+			Args:     &ast.List{Items: []ast.Node{expr}},
+			Location: loc,
+		}
 	}
+	return expr
+}
 
-	return aExpr
+func isNullConst(n ast.Node) bool {
+	if c, ok := n.(*ast.A_Const); ok {
+		_, ok := c.Val.(*ast.Null)
+		return ok
+	}
+	return false
 }
 
 func (c *cc) convertMultiSelect_stmtContext(n *parser.Select_stmtContext) ast.Node {
-	var tables []ast.Node
-	var cols []ast.Node
-	var where ast.Node
-	var groups = []ast.Node{}
-	var having ast.Node
+	with := c.convertCommon_table_stmtContext(n.Common_table_stmt())
 
-	for _, icore := range n.AllSelect_core() {
+	// The select cores of a compound SELECT form a tree, with the leftmost
+	// operation at the bottom
+	var stmt *ast.SelectStmt
+	ops := n.AllCompound_operator()
+	for i, icore := range n.AllSelect_core() {
 		core, ok := icore.(*parser.Select_coreContext)
 		if !ok {
 			continue
 		}
-		cols = append(cols, c.getCols(core)...)
-		tables = append(tables, c.getTables(core)...)
+		sel := c.convertSelect_coreContext(core)
+		if stmt == nil {
+			stmt = sel
+			continue
+		}
+		stmt = &ast.SelectStmt{
+			TargetList:   &ast.List{},
+			FromClause:   &ast.List{},
+			GroupClause:  &ast.List{},
+			WindowClause: &ast.List{},
+			ValuesLists:  &ast.List{},
+			Larg:         stmt,
+			Rarg:         sel,
+		}
+		if op, ok := ops[i-1].(*parser.Compound_operatorContext); ok {
+			switch {
+			case op.UNION_() != nil:
+				stmt.Op = ast.Union
+				stmt.All = op.ALL_() != nil
+			case op.INTERSECT_() != nil:
+				stmt.Op = ast.Intersect
+			case op.EXCEPT_() != nil:
+				stmt.Op = ast.Except
+			}
+		}
+	}
+	if stmt == nil {
+		return todo(n)
+	}
 
-		i := 0
-		if core.WHERE_() != nil {
-			where = c.convert(core.Expr(i))
-			i++
+	if n.Order_by_stmt() != nil {
+		if orderBy, ok := c.convertOrderby_stmtContext(n.Order_by_stmt()).(*ast.List); ok {
+			stmt.SortClause = orderBy
 		}
+	}
+	stmt.LimitCount, stmt.LimitOffset = c.convertLimit_stmtContext(n.Limit_stmt())
+	stmt.WithClause = with
+	return stmt
+}
 
-		if core.GROUP_() != nil {
-			l := len(core.AllExpr()) - i
-			if core.HAVING_() != nil {
-				having = c.convert(core.Expr(l))
-				l--
-			}
+func (c *cc) convertSelect_coreContext(core *parser.Select_coreContext) *ast.SelectStmt {
+	stmt := &ast.SelectStmt{
+		TargetList:   &ast.List{Items: c.getCols(core)},
+		FromClause:   &ast.List{Items: c.getTables(core)},
+		GroupClause:  &ast.List{Items: []ast.Node{}},
+		WindowClause: &ast.List{Items: []ast.Node{}},
+		ValuesLists:  &ast.List{},
+	}
 
-			for i < l {
-				groups = append(groups, c.convert(core.Expr(i)))
-				i++
-			}
+	i := 0
+	if core.WHERE_() != nil {
+		stmt.WhereClause = c.convert(core.Expr(i))
+		i++
+	}
+
+	if core.GROUP_() != nil {
+		l := len(core.AllExpr()) - i
+		if core.HAVING_() != nil {
+			stmt.HavingClause = c.convert(core.Expr(l))
+			l--
+		}
+
+		for i < l {
+			stmt.GroupClause.Items = append(stmt.GroupClause.Items, c.convert(core.Expr(i)))
+			i++
 		}
 	}
 
-	window := &ast.List{Items: []ast.Node{}}
-	if n.Order_by_stmt() != nil {
-		window.Items = append(window.Items, c.convert(n.Order_by_stmt()))
+	for i, name := range core.AllWindow_name() {
+		defn, ok := core.Window_defn(i).(*parser.Window_defnContext)
+		if !ok {
+			continue
+		}
+		def := c.convertWindow(defn)
+		windowName := identifier(name.GetText())
+		def.Name = &windowName
+		stmt.WindowClause.Items = append(stmt.WindowClause.Items, def)
 	}
+	return stmt
+}
 
-	limitCount, limitOffset := c.convertLimit_stmtContext(n.Limit_stmt())
+func (c *cc) convertCommon_table_stmtContext(n parser.ICommon_table_stmtContext) *ast.WithClause {
+	stmt, ok := n.(*parser.Common_table_stmtContext)
+	if !ok {
+		return nil
+	}
+	with := &ast.WithClause{
+		Ctes:      &ast.List{},
+		Recursive: stmt.RECURSIVE_() != nil,
+		Location:  stmt.GetStart().GetStart(),
+	}
+	for _, icte := range stmt.AllCommon_table_expression() {
+		cte, ok := icte.(*parser.Common_table_expressionContext)
+		if !ok {
+			continue
+		}
+		name := identifier(cte.Table_name().GetText())
+		cols := &ast.List{}
+		for _, col := range cte.AllColumn_name() {
+			cols.Items = append(cols.Items, NewIdentifer(col.GetText()))
+		}
+		with.Ctes.Items = append(with.Ctes.Items, &ast.CommonTableExpr{
+			Ctename:     &name,
+			Ctequery:    c.convert(cte.Select_stmt()),
+			Ctecolnames: cols,
+			Location:    cte.GetStart().GetStart(),
+		})
+	}
+	return with
+}
 
-	return &ast.SelectStmt{
-		FromClause:   &ast.List{Items: tables},
-		TargetList:   &ast.List{Items: cols},
-		WhereClause:  where,
-		GroupClause:  &ast.List{Items: groups},
-		HavingClause: having,
-		WindowClause: window,
-		LimitCount:   limitCount,
-		LimitOffset:  limitOffset,
-		ValuesLists:  &ast.List{},
+// convertWith_clauseContext converts the WITH clause of an INSERT, UPDATE or
+// DELETE statement.
+func (c *cc) convertWith_clauseContext(n parser.IWith_clauseContext) *ast.WithClause {
+	stmt, ok := n.(*parser.With_clauseContext)
+	if !ok {
+		return nil
+	}
+	with := &ast.WithClause{
+		Ctes:      &ast.List{},
+		Recursive: stmt.RECURSIVE_() != nil,
+		Location:  stmt.GetStart().GetStart(),
 	}
+	for i, iname := range stmt.AllCte_table_name() {
+		cte, ok := iname.(*parser.Cte_table_nameContext)
+		if !ok {
+			continue
+		}
+		name := identifier(cte.Table_name().GetText())
+		cols := &ast.List{}
+		for _, col := range cte.AllColumn_name() {
+			cols.Items = append(cols.Items, NewIdentifer(col.GetText()))
+		}
+		with.Ctes.Items = append(with.Ctes.Items, &ast.CommonTableExpr{
+			Ctename:     &name,
+			Ctequery:    c.convert(stmt.Select_stmt(i)),
+			Ctecolnames: cols,
+			Location:    cte.GetStart().GetStart(),
+		})
+	}
+	return with
 }
 
 func (c *cc) getTables(core *parser.Select_coreContext) []ast.Node {
@@ -392,16 +935,37 @@ func (c *cc) convertOrderby_stmtContext(n parser.IOrder_by_stmtContext) ast.Node
 			if !ok {
 				continue
 			}
-			list.Items = append(list.Items, &ast.CaseExpr{
-				Xpr:      c.convert(term.Expr()),
-				Location: term.Expr().GetStart().GetStart(),
-			})
+			list.Items = append(list.Items, c.convertOrdering_termContext(term))
 		}
 		return list
 	}
 	return todo(n)
 }
 
+func (c *cc) convertOrdering_termContext(n *parser.Ordering_termContext) *ast.SortBy {
+	sort := &ast.SortBy{
+		Node:      c.convert(n.Expr()),
+		SortbyDir: ast.SortByDirDefault,
+		UseOp:     &ast.List{},
+		Location:  n.GetStart().GetStart(),
+	}
+	if dir, ok := n.Asc_desc().(*parser.Asc_descContext); ok {
+		switch {
+		case dir.ASC_() != nil:
+			sort.SortbyDir = ast.SortByDirAsc
+		case dir.DESC_() != nil:
+			sort.SortbyDir = ast.SortByDirDesc
+		}
+	}
+	switch {
+	case n.NULLS_() != nil && n.FIRST_() != nil:
+		sort.SortbyNulls = ast.SortByNullsFirst
+	case n.NULLS_() != nil && n.LAST_() != nil:
+		sort.SortbyNulls = ast.SortByNullsLast
+	}
+	return sort
+}
+
 func (c *cc) convertLimit_stmtContext(n parser.ILimit_stmtContext) (ast.Node, ast.Node) {
 	if n == nil {
 		return nil, nil
@@ -496,17 +1060,48 @@ func (c *cc) convertSql_stmtContext(n *parser.Sql_stmtContext) ast.Node {
 
 func (c *cc) convertLiteral(n *parser.Expr_literalContext) ast.Node {
 	if literal, ok := n.Literal_value().(*parser.Literal_valueContext); ok {
+		loc := literal.GetStart().GetStart()
 
 		if literal.NUMERIC_LITERAL() != nil {
-			i, _ := strconv.ParseInt(literal.GetText(), 10, 64)
+			text := literal.GetText()
+			if strings.HasPrefix(text, "0x") || strings.HasPrefix(text, "0X") {
+				i, _ := strconv.ParseInt(text[2:], 16, 64)
+				return &ast.A_Const{
+					Val:      &ast.Integer{Ival: i},
+					Location: loc,
+				}
+			}
+			if strings.ContainsAny(text, ".eE") {
+				return &ast.A_Const{
+					Val:      &ast.Float{Str: text},
+					Location: loc,
+				}
+			}
+			i, _ := strconv.ParseInt(text, 10, 64)
 			return &ast.A_Const{
-				Val: &ast.Integer{Ival: i},
+				Val:      &ast.Integer{Ival: i},
+				Location: loc,
 			}
 		}
 
 		if literal.STRING_LITERAL() != nil {
 			return &ast.A_Const{
-				Val: &ast.String{Str: literal.GetText()},
+				Val:      &ast.String{Str: unquote(literal.GetText())},
+				Location: loc,
+			}
+		}
+
+		if literal.BLOB_LITERAL() != nil {
+			return &ast.A_Const{
+				Val:      &ast.BitString{Str: literal.GetText()},
+				Location: loc,
+			}
+		}
+
+		if literal.NULL_() != nil {
+			return &ast.A_Const{
+				Val:      &ast.Null{},
+				Location: loc,
 			}
 		}
 
@@ -517,7 +1112,25 @@ func (c *cc) convertLiteral(n *parser.Expr_literalContext) ast.Node {
 			}
 
 			return &ast.A_Const{
-				Val: &ast.Integer{Ival: i},
+				Val:      &ast.Integer{Ival: i},
+				Location: loc,
+			}
+		}
+
+		var op ast.SQLValueFunctionOp
+		switch {
+		case literal.CURRENT_DATE_() != nil:
+			op = ast.SQLValueFunctionOpCurrentDate
+		case literal.CURRENT_TIME_() != nil:
+			op = ast.SQLValueFunctionOpCurrentTime
+		case literal.CURRENT_TIMESTAMP_() != nil:
+			op = ast.SQLValueFunctionOpCurrentTimestamp
+		}
+		if op != 0 {
+			return &ast.SQLValueFunction{
+				Op:       op,
+				Typmod:   -1,
+				Location: loc,
 			}
 		}
 	}
@@ -526,28 +1139,125 @@ func (c *cc) convertLiteral(n *parser.Expr_literalContext) ast.Node {
 
 func (c *cc) convertMathOperationNode(n *parser.Expr_math_opContext) ast.Node {
 	return &ast.A_Expr{
+		Kind: ast.A_Expr_Kind_OP,
 		Name: &ast.List{
 			Items: []ast.Node{
-				&ast.String{Str: "+"}, // todo: Convert operation types
+				&ast.String{Str: operator(n)},
 			},
 		},
-		Lexpr: c.convert(n.Expr(0)),
-		Rexpr: c.convert(n.Expr(1)),
+		Lexpr:    c.convert(n.Expr(0)),
+		Rexpr:    c.convert(n.Expr(1)),
+		Location: n.GetStart().GetStart(),
 	}
 }
 
 func (c *cc) convertBinaryNode(n *parser.Expr_binaryContext) ast.Node {
+	if n.PIPE2() != nil {
+		op := "||"
+		if name, ok := c.jsonOps[n.PIPE2().GetSymbol().GetStart()]; ok {
+			op = name
+		}
+		return &ast.A_Expr{
+			Kind: ast.A_Expr_Kind_OP,
+			Name: &ast.List{
+				Items: []ast.Node{
+					&ast.String{Str: op},
+				},
+			},
+			Lexpr:    c.convert(n.Expr(0)),
+			Rexpr:    c.convert(n.Expr(1)),
+			Location: n.GetStart().GetStart(),
+		}
+	}
+	op := ast.BoolExprTypeAnd
+	if n.OR_() != nil {
+		op = ast.BoolExprTypeOr
+	}
 	return &ast.BoolExpr{
-		// TODO: Set op
+		Boolop: op,
 		Args: &ast.List{
 			Items: []ast.Node{
 				c.convert(n.Expr(0)),
 				c.convert(n.Expr(1)),
 			},
 		},
+		Location: n.GetStart().GetStart(),
 	}
 }
 
+func (c *cc) convertUnaryExpr(n *parser.Expr_unaryContext) ast.Node {
+	op, ok := n.Unary_operator().(*parser.Unary_operatorContext)
+	if !ok {
+		return todo(n)
+	}
+	loc := n.GetStart().GetStart()
+	arg := c.convert(n.Expr())
+	switch {
+	case op.NOT_() != nil:
+		return &ast.BoolExpr{
+			Boolop:   ast.BoolExprTypeNot,
+			Args:     &ast.List{Items: []ast.Node{arg}},
+			Location: loc,
+		}
+	case op.PLUS() != nil:
+		return arg
+	case op.MINUS() != nil:
+		// Negative numbers are constants
+		if a, ok := arg.(*ast.A_Const); ok {
+			switch v := a.Val.(type) {
+			case *ast.Integer:
+				return &ast.A_Const{Val: &ast.Integer{Ival: -v.Ival}, Location: loc}
+			case *ast.Float:
+				return &ast.A_Const{Val: &ast.Float{Str: "-" + v.Str}, Location: loc}
+			}
+		}
+	}
+	return &ast.A_Expr{
+		Kind: ast.A_Expr_Kind_OP,
+		Name: &ast.List{
+			Items: []ast.Node{
+				&ast.String{Str: op.GetText()},
+			},
+		},
+		Rexpr:    arg,
+		Location: loc,
+	}
+}
+
+func (c *cc) convertCastExpr(n *parser.Expr_castContext) ast.Node {
+	name := strings.ToLower(n.Type_name().GetText())
+	return &ast.TypeCast{
+		Arg: c.convert(n.Expr()),
+		TypeName: &ast.TypeName{
+			Name:  name,
+			Names: &ast.List{Items: []ast.Node{NewIdentifer(name)}},
+		},
+		Location: n.GetStart().GetStart(),
+	}
+}
+
+func (c *cc) convertCollateExpr(n *parser.Expr_collateContext) ast.Node {
+	return &ast.CollateClause{
+		Arg: c.convert(n.Expr()),
+		Collname: &ast.List{
+			Items: []ast.Node{NewIdentifer(n.Collation_name().GetText())},
+		},
+		Location: n.COLLATE_().GetSymbol().GetStart(),
+	}
+}
+
+func (c *cc) convertNullComparison(n *parser.Expr_null_compContext) ast.Node {
+	test := &ast.NullTest{
+		Arg:          c.convert(n.Expr()),
+		Nulltesttype: ast.NullTestTypeIsNull,
+		Location:     n.GetStart().GetStart(),
+	}
+	if n.NOTNULL_() != nil || n.NOT_() != nil {
+		test.Nulltesttype = ast.NullTestTypeIsNotNull
+	}
+	return test
+}
+
 func (c *cc) convertParam(n *parser.Expr_bindContext) ast.Node {
 	if n.BIND_PARAMETER() != nil {
 		// Parameter numbers start at one
@@ -561,7 +1271,86 @@ func (c *cc) convertParam(n *parser.Expr_bindContext) ast.Node {
 }
 
 func (c *cc) convertInSelectNode(n *parser.Expr_in_selectContext) ast.Node {
-	return c.convert(n.Select_stmt())
+	loc := n.GetStart().GetStart()
+	if n.IN_() == nil {
+		// A subquery, either on its own or after EXISTS
+		link := &ast.SubLink{
+			SubLinkType: ast.EXPR_SUBLINK,
+			Subselect:   c.convert(n.Select_stmt()),
+			Location:    loc,
+		}
+		if n.EXISTS_() != nil {
+			link.SubLinkType = ast.EXISTS_SUBLINK
+		}
+		return not(link, n.NOT_() != nil)
+	}
+
+	lexpr := c.convert(n.Expr(0))
+	switch {
+	case n.Select_stmt() != nil:
+		return not(&ast.SubLink{
+			SubLinkType: ast.ANY_SUBLINK,
+			Testexpr:    lexpr,
+			Subselect:   c.convert(n.Select_stmt()),
+			Location:    loc,
+		}, n.NOT_() != nil)
+
+	case n.Table_name() != nil:
+		// x IN t is x IN (SELECT * FROM t)
+		rel := parseTableName(n)
+		rv := &ast.RangeVar{
+			Relname:  &rel.Name,
+			Location: n.Table_name().GetStart().GetStart(),
+		}
+		if rel.Schema != "" {
+			rv.Schemaname = &rel.Schema
+		}
+		return not(&ast.SubLink{
+			SubLinkType: ast.ANY_SUBLINK,
+			Testexpr:    lexpr,
+			Subselect: &ast.SelectStmt{
+				TargetList: &ast.List{
+					Items: []ast.Node{
+						&ast.ResTarget{
+							Val: &ast.ColumnRef{
+								Fields: &ast.List{Items: []ast.Node{&ast.A_Star{}}},
+							},
+						},
+					},
+				},
+				FromClause:   &ast.List{Items: []ast.Node{rv}},
+				GroupClause:  &ast.List{},
+				WindowClause: &ast.List{},
+				ValuesLists:  &ast.List{},
+			},
+			Location: loc,
+		}, n.NOT_() != nil)
+
+	case n.Table_function_name() != nil:
+		return todo(n)
+	}
+
+	in := &ast.In{
+		Expr:     lexpr,
+		List:     []ast.Node{},
+		Not:      n.NOT_() != nil,
+		Location: loc,
+	}
+	for _, expr := range n.AllExpr()[1:] {
+		in.List = append(in.List, c.convert(expr))
+	}
+	return in
+}
+
+// not negates an expression when negate is set.
+func not(n ast.Node, negate bool) ast.Node {
+	if !negate {
+		return n
+	}
+	return &ast.BoolExpr{
+		Boolop: ast.BoolExprTypeNot,
+		Args:   &ast.List{Items: []ast.Node{n}},
+	}
 }
 
 func (c *cc) convertReturning_caluseContext(n parser.IReturning_clauseContext) *ast.List {
@@ -575,30 +1364,46 @@ func (c *cc) convertReturning_caluseContext(n parser.IReturning_clauseContext) *
 		return list
 	}
 
-	for _, exp := range r.AllExpr() {
-		list.Items = append(list.Items, &ast.ResTarget{
-			Indirection: &ast.List{},
-			Val:         c.convert(exp),
-		})
-	}
-
-	for _, star := range r.AllSTAR() {
-		list.Items = append(list.Items, &ast.ResTarget{
-			Indirection: &ast.List{},
-			Val: &ast.ColumnRef{
-				Fields: &ast.List{
-					Items: []ast.Node{&ast.A_Star{}},
+	// Stars, expressions and their aliases are converted in the order they
+	// are written
+	var last *ast.ResTarget
+	for _, child := range r.GetChildren() {
+		switch child := child.(type) {
+		case antlr.TerminalNode:
+			if child.GetSymbol().GetTokenType() != parser.SQLiteParserSTAR {
+				continue
+			}
+			last = &ast.ResTarget{
+				Indirection: &ast.List{},
+				Val: &ast.ColumnRef{
+					Fields: &ast.List{
+						Items: []ast.Node{&ast.A_Star{}},
+					},
+					Location: child.GetSymbol().GetStart(),
 				},
-				Location: star.GetSymbol().GetStart(),
-			},
-			Location: star.GetSymbol().GetStart(),
-		})
+				Location: child.GetSymbol().GetStart(),
+			}
+			list.Items = append(list.Items, last)
+		case parser.IExprContext:
+			last = &ast.ResTarget{
+				Indirection: &ast.List{},
+				Val:         c.convert(child),
+				Location:    child.GetStart().GetStart(),
+			}
+			list.Items = append(list.Items, last)
+		case parser.IColumn_aliasContext:
+			if last != nil {
+				name := child.GetText()
+				last.Name = &name
+			}
+		}
 	}
 
 	return list
 }
 
 func (c *cc) convertInsert_stmtContext(n *parser.Insert_stmtContext) ast.Node {
+	with := c.convertWith_clauseContext(n.With_clause())
 	tableName := n.Table_name().GetText()
 	rel := &ast.RangeVar{
 		Relname: &tableName,
@@ -615,9 +1420,9 @@ func (c *cc) convertInsert_stmtContext(n *parser.Insert_stmtContext) ast.Node {
 	}
 
 	insert := &ast.InsertStmt{
-		Relation:      rel,
-		Cols:          c.convertColumnNames(n.AllColumn_name()),
-		ReturningList: c.convertReturning_caluseContext(n.Returning_clause()),
+		Relation:   rel,
+		Cols:       c.convertColumnNames(n.AllColumn_name()),
+		WithClause: with,
 	}
 
 	if n.Select_stmt() != nil {
@@ -629,21 +1434,153 @@ func (c *cc) convertInsert_stmtContext(n *parser.Insert_stmtContext) ast.Node {
 		insert.SelectStmt = &ast.SelectStmt{
 			FromClause:  &ast.List{},
 			TargetList:  &ast.List{},
-			ValuesLists: c.convertExprLists(n.AllExpr()),
+			ValuesLists: c.convertValuesLists(n),
 		}
 	}
+	insert.OnConflictClause = c.convertUpsert_clauseContext(n.Upsert_clause())
+	if insert.OnConflictClause == nil {
+		insert.OnConflictClause = convertConflictResolution(n)
+	}
+	insert.ReturningList = c.convertReturning_caluseContext(n.Returning_clause())
 
 	return insert
 }
 
-func (c *cc) convertExprLists(lists []parser.IExprContext) *ast.List {
+// convertConflictResolution returns the conflict clause of INSERT OR IGNORE,
+// INSERT OR REPLACE and REPLACE. The other algorithms only decide how a
+// failed statement is undone.
+func convertConflictResolution(n *parser.Insert_stmtContext) *ast.OnConflictClause {
+	var action ast.OnConflictAction
+	switch {
+	case n.REPLACE_() != nil:
+		action = ast.OnConflictActionReplace
+	case n.IGNORE_() != nil:
+		action = ast.OnConflictActionNothing
+	default:
+		return nil
+	}
+	return &ast.OnConflictClause{
+		Action:     action,
+		TargetList: &ast.List{},
+		Location:   n.GetStart().GetStart(),
+	}
+}
+
+func (c *cc) convertUpsert_clauseContext(n parser.IUpsert_clauseContext) *ast.OnConflictClause {
+	upsert, ok := n.(*parser.Upsert_clauseContext)
+	if !ok {
+		return nil
+	}
+	clause := &ast.OnConflictClause{
+		Action:     ast.OnConflictActionNothing,
+		TargetList: &ast.List{},
+		Location:   upsert.GetStart().GetStart(),
+	}
+	if upsert.UPDATE_() != nil {
+		clause.Action = ast.OnConflictActionUpdate
+	}
+	if upsert.OPEN_PAR() != nil {
+		clause.Infer = &ast.InferClause{
+			IndexElems: &ast.List{},
+			Location:   upsert.OPEN_PAR().GetSymbol().GetStart(),
+		}
+		for _, col := range upsert.AllIndexed_column() {
+			col := col.(*parser.Indexed_columnContext)
+			elem := &ast.IndexElem{}
+			if col.Column_name() != nil {
+				name := identifier(col.Column_name().GetText())
+				elem.Name = &name
+			} else {
+				elem.Expr = c.convert(col.Expr())
+			}
+			clause.Infer.IndexElems.Items = append(clause.Infer.IndexElems.Items, elem)
+		}
+	}
+
+	// The expressions of the clause are converted in the order they are
+	// written, as parameters are numbered in that order
+	var set bool
+	var where bool
+	var names []string
+	for _, child := range upsert.GetChildren() {
+		switch child := child.(type) {
+		case antlr.TerminalNode:
+			switch child.GetSymbol().GetTokenType() {
+			case parser.SQLiteParserSET_:
+				set = true
+			case parser.SQLiteParserWHERE_:
+				where = true
+			}
+		case parser.IColumn_nameContext:
+			names = []string{identifier(child.GetText())}
+		case *parser.Column_name_listContext:
+			names = nil
+			for _, col := range child.AllColumn_name() {
+				names = append(names, identifier(col.GetText()))
+			}
+		case parser.IExprContext:
+			expr := c.convert(child)
+			switch {
+			case where && !set:
+				clause.Infer.WhereClause = expr
+			case where:
+				clause.WhereClause = expr
+			case len(names) == 1:
+				clause.TargetList.Items = append(clause.TargetList.Items, &ast.ResTarget{
+					Name:     &names[0],
+					Val:      expr,
+					Location: child.GetStart().GetStart(),
+				})
+			default:
+				for i := range names {
+					clause.TargetList.Items = append(clause.TargetList.Items, &ast.ResTarget{
+						Name: &names[i],
+						Val: &ast.MultiAssignRef{
+							Source:   expr,
+							Colno:    i + 1,
+							Ncolumns: len(names),
+						},
+						Location: child.GetStart().GetStart(),
+					})
+				}
+			}
+			where = false
+		}
+	}
+	return clause
+}
+
+// convertValuesLists returns a list for each row of the VALUES clause of an
+// INSERT statement.
+func (c *cc) convertValuesLists(n *parser.Insert_stmtContext) *ast.List {
 	list := &ast.List{Items: []ast.Node{}}
-	n := len(lists)
-	inner := &ast.List{Items: []ast.Node{}}
-	for i := 0; i < n; i++ {
-		inner.Items = append(inner.Items, c.convert(lists[i]))
+	if n.VALUES_() == nil {
+		return list
+	}
+	var values bool
+	var row *ast.List
+	for _, child := range n.GetChildren() {
+		switch child := child.(type) {
+		case antlr.TerminalNode:
+			switch child.GetSymbol().GetTokenType() {
+			case parser.SQLiteParserVALUES_:
+				values = true
+			case parser.SQLiteParserOPEN_PAR:
+				if values {
+					row = &ast.List{Items: []ast.Node{}}
+				}
+			case parser.SQLiteParserCLOSE_PAR:
+				if values && row != nil {
+					list.Items = append(list.Items, row)
+					row = nil
+				}
+			}
+		case parser.IExprContext:
+			if row != nil {
+				row.Items = append(row.Items, c.convert(child))
+			}
+		}
 	}
-	list.Items = append(list.Items, inner)
 	return list
 }
 
@@ -658,6 +1595,67 @@ func (c *cc) convertColumnNames(cols []parser.IColumn_nameContext) *ast.List {
 	return list
 }
 
+func (c *cc) convertTableFunction(n *parser.Table_or_subqueryContext, name string, args *ast.List, alias *ast.Alias) *ast.RangeFunction {
+	return &ast.RangeFunction{
+		Functions: &ast.List{
+			Items: []ast.Node{
+				&ast.FuncCall{
+					Func: &ast.FuncName{
+						Name: name,
+					},
+					Funcname: &ast.List{
+						Items: []ast.Node{
+							NewIdentifer(name),
+						},
+					},
+					Args:     args,
+					Location: n.GetStart().GetStart(),
+				},
+			},
+		},
+		Alias: alias,
+	}
+}
+
+// tableFunctionArgument returns the argument of a table-valued function
+// called with a single name or string, such as pragma_table_info('t'). The
+// grammar parses these as a table with a parenthesized alias.
+func tableFunctionArgument(n *parser.Table_or_subqueryContext) (*parser.Any_nameContext, bool) {
+	if n.Table_name() == nil || n.AS_() != nil {
+		return nil, false
+	}
+	alias, ok := n.Table_alias().(*parser.Table_aliasContext)
+	if !ok {
+		return nil, false
+	}
+	name, ok := alias.Any_name().(*parser.Any_nameContext)
+	if !ok || name.OPEN_PAR() == nil {
+		return nil, false
+	}
+	arg, ok := name.Any_name().(*parser.Any_nameContext)
+	return arg, ok
+}
+
+func (c *cc) convertAnyName(n *parser.Any_nameContext) ast.Node {
+	switch {
+	case n.STRING_LITERAL() != nil:
+		return &ast.A_Const{
+			Val:      &ast.String{Str: unquote(n.GetText())},
+			Location: n.GetStart().GetStart(),
+		}
+	case n.OPEN_PAR() != nil:
+		if inner, ok := n.Any_name().(*parser.Any_nameContext); ok {
+			return c.convertAnyName(inner)
+		}
+	}
+	return &ast.ColumnRef{
+		Fields: &ast.List{
+			Items: []ast.Node{NewIdentifer(n.GetText())},
+		},
+		Location: n.GetStart().GetStart(),
+	}
+}
+
 func (c *cc) convertTablesOrSubquery(n []parser.ITable_or_subqueryContext) []ast.Node {
 	var tables []ast.Node
 	for _, ifrom := range n {
@@ -666,7 +1664,10 @@ func (c *cc) convertTablesOrSubquery(n []parser.ITable_or_subqueryContext) []ast
 			continue
 		}
 
-		if from.Table_name() != nil {
+		if arg, ok := tableFunctionArgument(from); ok {
+			args := &ast.List{Items: []ast.Node{c.convertAnyName(arg)}}
+			tables = append(tables, c.convertTableFunction(from, from.Table_name().GetText(), args, nil))
+		} else if from.Table_name() != nil {
 			rel := from.Table_name().GetText()
 			rv := &ast.RangeVar{
 				Relname:  &rel,
@@ -684,34 +1685,16 @@ func (c *cc) convertTablesOrSubquery(n []parser.ITable_or_subqueryContext) []ast
 
 			tables = append(tables, rv)
 		} else if from.Table_function_name() != nil {
-			rel := from.Table_function_name().GetText()
-			rf := &ast.RangeFunction{
-				Functions: &ast.List{
-					Items: []ast.Node{
-						&ast.FuncCall{
-							Func: &ast.FuncName{
-								Name: rel,
-							},
-							Funcname: &ast.List{
-								Items: []ast.Node{
-									NewIdentifer(rel),
-								},
-							},
-							Args: &ast.List{
-								Items: []ast.Node{&ast.TODO{}},
-							},
-							Location: from.GetStart().GetStart(),
-						},
-					},
-				},
-			}
-
+			var alias *ast.Alias
 			if from.Table_alias() != nil {
-				alias := from.Table_alias().GetText()
-				rf.Alias = &ast.Alias{Aliasname: &alias}
+				name := from.Table_alias().GetText()
+				alias = &ast.Alias{Aliasname: &name}
 			}
-
-			tables = append(tables, rf)
+			args := &ast.List{Items: []ast.Node{}}
+			for _, expr := range from.AllExpr() {
+				args.Items = append(args.Items, c.convert(expr))
+			}
+			tables = append(tables, c.convertTableFunction(from, from.Table_function_name().GetText(), args, alias))
 		} else if from.Select_stmt() != nil {
 			rs := &ast.RangeSubselect{
 				Subquery: c.convert(from.Select_stmt()),
@@ -734,6 +1717,7 @@ func (c *cc) convertUpdate_stmtContext(n *parser.Update_stmtContext) ast.Node {
 		return nil
 	}
 
+	with := c.convertWith_clauseContext(n.With_clause())
 	relations := &ast.List{}
 	tableName := n.Qualified_table_name().GetText()
 	rel := ast.RangeVar{
@@ -763,7 +1747,7 @@ func (c *cc) convertUpdate_stmtContext(n *parser.Update_stmtContext) ast.Node {
 		WhereClause:   where,
 		ReturningList: c.convertReturning_caluseContext(n.Returning_clause()),
 		FromClause:    &ast.List{},
-		WithClause:    nil, // TODO: support with clause
+		WithClause:    with,
 	}
 }
 
@@ -777,6 +1761,55 @@ func (c *cc) convertBetweenExpr(n *parser.Expr_betweenContext) ast.Node {
 	}
 }
 
+func (c *cc) convertExprListContext(n *parser.Expr_listContext) ast.Node {
+	exprs := n.AllExpr()
+	if len(exprs) == 1 {
+		return c.convert(exprs[0])
+	}
+	row := &ast.RowExpr{
+		Args:     &ast.List{},
+		Location: n.GetStart().GetStart(),
+	}
+	for _, expr := range exprs {
+		row.Args.Items = append(row.Args.Items, c.convert(expr))
+	}
+	return row
+}
+
+func (c *cc) convertCaseContext(n *parser.Expr_caseContext) ast.Node {
+	e := &ast.CaseExpr{
+		Args:     &ast.List{},
+		Location: n.GetStart().GetStart(),
+	}
+	var when *ast.CaseWhen
+	var elseExpr bool
+	for _, child := range n.GetChildren() {
+		switch child := child.(type) {
+		case antlr.TerminalNode:
+			switch child.GetSymbol().GetTokenType() {
+			case parser.SQLiteParserWHEN_:
+				when = &ast.CaseWhen{Location: child.GetSymbol().GetStart()}
+				e.Args.Items = append(e.Args.Items, when)
+			case parser.SQLiteParserELSE_:
+				elseExpr = true
+			}
+		case parser.IExprContext:
+			expr := c.convert(child)
+			switch {
+			case elseExpr:
+				e.Defresult = expr
+			case when == nil:
+				e.Arg = expr
+			case when.Expr == nil:
+				when.Expr = expr
+			default:
+				when.Result = expr
+			}
+		}
+	}
+	return e
+}
+
 func (c *cc) convert(node node) ast.Node {
 	switch n := node.(type) {
 
@@ -792,6 +1825,9 @@ func (c *cc) convert(node node) ast.Node {
 	case *parser.Create_view_stmtContext:
 		return c.convertCreate_view_stmtContext(n)
 
+	case *parser.Create_virtual_table_stmtContext:
+		return c.convertCreate_virtual_table_stmtContext(n)
+
 	case *parser.Drop_stmtContext:
 		return c.convertDrop_stmtContext(n)
 
@@ -828,9 +1864,26 @@ func (c *cc) convert(node node) ast.Node {
 	case *parser.Expr_betweenContext:
 		return c.convertBetweenExpr(n)
 
+	case *parser.Expr_caseContext:
+		return c.convertCaseContext(n)
+
+	case *parser.Expr_castContext:
+		return c.convertCastExpr(n)
+
+	case *parser.Expr_collateContext:
+		return c.convertCollateExpr(n)
+
+	case *parser.Expr_null_compContext:
+		return c.convertNullComparison(n)
+
+	case *parser.Expr_unaryContext:
+		return c.convertUnaryExpr(n)
+
+	case *parser.Expr_listContext:
+		return c.convertExprListContext(n)
+
 	case *parser.Factored_select_stmtContext:
-		// TODO: need to handle this
-		return todo(n)
+		return c.convert(n.Select_stmt())
 
 	case *parser.Insert_stmtContext:
 		return c.convertInsert_stmtContext(n)
@@ -0,0 +1,91 @@
+package sqlite
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stephenwithav/sqlc/pkg/sql/ast"
+)
+
+func TestConvertIs(t *testing.T) {
+	p := NewParser()
+
+	for _, tc := range []struct {
+		where string
+		want  func(ast.Node) bool
+	}{
+		{
+			"b IS NULL",
+			func(n ast.Node) bool {
+				test, ok := n.(*ast.NullTest)
+				return ok && test.Nulltesttype == ast.NullTestTypeIsNull
+			},
+		},
+		{
+			"b IS NOT NULL",
+			func(n ast.Node) bool {
+				test, ok := n.(*ast.NullTest)
+				return ok && test.Nulltesttype == ast.NullTestTypeIsNotNull
+			},
+		},
+		{
+			"b IS ?",
+			func(n ast.Node) bool {
+				expr, ok := n.(*ast.A_Expr)
+				if !ok || expr.Kind != ast.A_Expr_Kind_NOT_DISTINCT {
+					return false
+				}
+				_, ok = expr.Rexpr.(*ast.ParamRef)
+				return ok
+			},
+		},
+		{
+			"b IS NOT ?",
+			func(n ast.Node) bool {
+				expr, ok := n.(*ast.A_Expr)
+				if !ok || expr.Kind != ast.A_Expr_Kind_DISTINCT {
+					return false
+				}
+				_, ok = expr.Rexpr.(*ast.ParamRef)
+				return ok
+			},
+		},
+		{
+			"b IS NOT 3",
+			func(n ast.Node) bool {
+				expr, ok := n.(*ast.A_Expr)
+				if !ok || expr.Kind != ast.A_Expr_Kind_DISTINCT {
+					return false
+				}
+				_, ok = expr.Rexpr.(*ast.A_Const)
+				return ok
+			},
+		},
+		{
+			"b IS (NOT c)",
+			func(n ast.Node) bool {
+				expr, ok := n.(*ast.A_Expr)
+				if !ok || expr.Kind != ast.A_Expr_Kind_NOT_DISTINCT {
+					return false
+				}
+				_, ok = expr.Rexpr.(*ast.BoolExpr)
+				return ok
+			},
+		},
+	} {
+		tc := tc
+		t.Run(tc.where, func(t *testing.T) {
+			stmts, err := p.Parse(strings.NewReader("SELECT a FROM t WHERE " + tc.where + ";"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			sel, ok := stmts[0].Raw.Stmt.(*ast.SelectStmt)
+			if !ok {
+				t.Fatalf("expected a SELECT, got %T", stmts[0].Raw.Stmt)
+			}
+			if !tc.want(sel.WhereClause) {
+				t.Errorf("unexpected WHERE clause: %#v", sel.WhereClause)
+			}
+		})
+	}
+}
@@ -0,0 +1,186 @@
+//go:build cgo
+
+package sqlite
+
+import (
+	"database/sql"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+// The tables in which virtual tables keep their contents
+// https://www.sqlite.org/vtab.html#xshadowname
+var shadowSuffixes = map[string]bool{
+	// fts3, fts4 and fts5
+	"config":   true,
+	"content":  true,
+	"data":     true,
+	"docsize":  true,
+	"idx":      true,
+	"segdir":   true,
+	"segments": true,
+	"stat":     true,
+	// rtree
+	"node":   true,
+	"parent": true,
+	"rowid":  true,
+}
+
+// ReadSchema returns the statements which created the schema of a database
+// file, as they are kept in sqlite_schema. Internal tables and the shadow
+// tables of virtual tables are left out. A table whose statement sqlc can't
+// parse is recreated from its columns.
+func ReadSchema(path string) (string, error) {
+	if _, err := os.Stat(path); err != nil {
+		return "", err
+	}
+	uri, err := databaseURI(path)
+	if err != nil {
+		return "", err
+	}
+	db, err := sql.Open("sqlite3", uri)
+	if err != nil {
+		return "", err
+	}
+	defer db.Close()
+
+	rows, err := db.Query(`
+SELECT type, name, sql
+FROM sqlite_schema
+WHERE sql IS NOT NULL AND name NOT LIKE 'sqlite\_%' ESCAPE '\'
+ORDER BY rowid`)
+	if err != nil {
+		return "", fmt.Errorf("%s: %w", path, err)
+	}
+	defer rows.Close()
+
+	type object struct {
+		typ, name, sql string
+	}
+	var objects []object
+	virtual := map[string]bool{}
+	for rows.Next() {
+		var o object
+		if err := rows.Scan(&o.typ, &o.name, &o.sql); err != nil {
+			return "", err
+		}
+		if o.typ == "table" && strings.HasPrefix(strings.ToUpper(o.sql), "CREATE VIRTUAL TABLE") {
+			virtual[o.name] = true
+		}
+		objects = append(objects, o)
+	}
+	if err := rows.Err(); err != nil {
+		return "", fmt.Errorf("%s: %w", path, err)
+	}
+
+	p := NewParser()
+	var b strings.Builder
+	for _, o := range objects {
+		if o.typ == "table" && isShadowTable(o.name, virtual) {
+			continue
+		}
+		stmt := o.sql
+		if _, err := p.parse(stmt, true); err != nil && o.typ == "table" {
+			stmt, err = p.tableColumns(db, o.name)
+			if err != nil {
+				return "", fmt.Errorf("%s: %w", path, err)
+			}
+		}
+		b.WriteString(stmt)
+		b.WriteString(";\n")
+	}
+	return b.String(), nil
+}
+
+// tableColumns returns a statement which creates a table with the columns,
+// primary key and table options that SQLite reports for it. The hidden
+// columns of virtual tables are left out.
+func (p *Parser) tableColumns(db *sql.DB, name string) (string, error) {
+	rows, err := db.Query(`
+SELECT name, type, "notnull", pk
+FROM pragma_table_xinfo(?)
+WHERE hidden <> 1
+ORDER BY cid`, name)
+	if err != nil {
+		return "", err
+	}
+	defer rows.Close()
+
+	var defs []string
+	pk := map[int]string{}
+	for rows.Next() {
+		var col, typ string
+		var notNull bool
+		var key int
+		if err := rows.Scan(&col, &typ, &notNull, &key); err != nil {
+			return "", err
+		}
+		def := p.QuoteIdent(col)
+		if typ != "" {
+			def += " " + typ
+		}
+		if notNull {
+			def += " NOT NULL"
+		}
+		if key > 0 {
+			pk[key] = p.QuoteIdent(col)
+		}
+		defs = append(defs, def)
+	}
+	if err := rows.Err(); err != nil {
+		return "", err
+	}
+	if len(pk) > 0 {
+		keys := make([]string, len(pk))
+		for i := range keys {
+			keys[i] = pk[i+1]
+		}
+		defs = append(defs, "PRIMARY KEY ("+strings.Join(keys, ", ")+")")
+	}
+
+	var withoutRowid, strict bool
+	err = db.QueryRow(`SELECT wr, strict FROM pragma_table_list(?) WHERE schema = 'main'`, name).Scan(&withoutRowid, &strict)
+	if err != nil {
+		return "", err
+	}
+	var opts []string
+	if withoutRowid {
+		opts = append(opts, "WITHOUT ROWID")
+	}
+	if strict {
+		opts = append(opts, "STRICT")
+	}
+
+	stmt := "CREATE TABLE " + p.QuoteIdent(name) + " (" + strings.Join(defs, ", ") + ")"
+	if len(opts) > 0 {
+		stmt += " " + strings.Join(opts, ", ")
+	}
+	return stmt, nil
+}
+
+func isShadowTable(name string, virtual map[string]bool) bool {
+	i := strings.LastIndex(name, "_")
+	return i > 0 && virtual[name[:i]] && shadowSuffixes[name[i+1:]]
+}
+
+// databaseURI returns the URI which opens a database file read-only. The
+// path is escaped, as SQLite would take a ? or # in it for the start of the
+// query or fragment.
+func databaseURI(path string) (string, error) {
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return "", err
+	}
+	abs = filepath.ToSlash(abs)
+	if !strings.HasPrefix(abs, "/") {
+		// A Windows drive letter
+		abs = "/" + abs
+	}
+	u := url.URL{Scheme: "file", Path: abs, RawQuery: "mode=ro"}
+	return u.String(), nil
+}
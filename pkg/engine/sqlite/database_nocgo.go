@@ -0,0 +1,9 @@
+//go:build !cgo
+
+package sqlite
+
+import "errors"
+
+func ReadSchema(path string) (string, error) {
+	return "", errors.New("sqlc built without cgo support can't read a schema from a database")
+}
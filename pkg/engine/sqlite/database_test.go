@@ -0,0 +1,112 @@
+//go:build cgo
+
+package sqlite
+
+import (
+	"database/sql"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestReadSchema(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "app.db")
+	db, err := sql.Open("sqlite3", path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, stmt := range []string{
+		"CREATE TABLE authors (id integer PRIMARY KEY AUTOINCREMENT, name text NOT NULL)",
+		"CREATE INDEX authors_name ON authors (name)",
+		"CREATE VIEW names AS SELECT name FROM authors",
+		"CREATE VIRTUAL TABLE docs USING fts4(body)",
+		"CREATE TABLE docs_archive (body text)",
+	} {
+		if _, err := db.Exec(stmt); err != nil {
+			t.Fatal(err)
+		}
+	}
+	db.Close()
+
+	schema, err := ReadSchema(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `CREATE TABLE authors (id integer PRIMARY KEY AUTOINCREMENT, name text NOT NULL);
+CREATE INDEX authors_name ON authors (name);
+CREATE VIEW names AS SELECT name FROM authors;
+CREATE VIRTUAL TABLE docs USING fts4(body);
+CREATE TABLE docs_archive (body text);
+`
+	if diff := cmp.Diff(want, schema); diff != "" {
+		t.Errorf("schema differed (-want +got):\n%s", diff)
+	}
+
+	if _, err := NewParser().Parse(strings.NewReader(schema)); err != nil {
+		t.Errorf("parse schema: %s", err)
+	}
+
+	if _, err := ReadSchema(filepath.Join(t.TempDir(), "missing.db")); err == nil {
+		t.Errorf("expected an error for a missing file")
+	}
+}
+
+func TestReadSchemaEscapesPath(t *testing.T) {
+	// The driver would cut the DSN at the ?, so the file is moved into
+	// place once it is written
+	tmp := t.TempDir()
+	db, err := sql.Open("sqlite3", filepath.Join(tmp, "app.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.Exec("CREATE TABLE authors (id integer PRIMARY KEY)"); err != nil {
+		t.Fatal(err)
+	}
+	db.Close()
+	path := filepath.Join(t.TempDir(), "a b?c#d%20.db")
+	if err := os.Rename(filepath.Join(tmp, "app.db"), path); err != nil {
+		t.Fatal(err)
+	}
+
+	schema, err := ReadSchema(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "CREATE TABLE authors (id integer PRIMARY KEY);\n"; schema != want {
+		t.Errorf("expected %q; got %q", want, schema)
+	}
+}
+
+func TestReadSchemaFromColumns(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "app.db")
+	db, err := sql.Open("sqlite3", path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// sqlc's grammar has no IS NOT DISTINCT FROM
+	stmt := `CREATE TABLE tags (
+  author_id integer NOT NULL,
+  "order" text NOT NULL CHECK ("order" IS NOT DISTINCT FROM trim("order")),
+  weight real,
+  PRIMARY KEY (author_id, "order")
+) WITHOUT ROWID, STRICT`
+	if _, err := db.Exec(stmt); err != nil {
+		t.Fatal(err)
+	}
+	db.Close()
+
+	schema, err := ReadSchema(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `CREATE TABLE tags (author_id INTEGER NOT NULL, "order" TEXT NOT NULL, weight REAL, PRIMARY KEY (author_id, "order")) WITHOUT ROWID, STRICT;` + "\n"
+	if diff := cmp.Diff(want, schema); diff != "" {
+		t.Errorf("schema differed (-want +got):\n%s", diff)
+	}
+	if _, err := NewParser().Parse(strings.NewReader(schema)); err != nil {
+		t.Errorf("parse schema: %s", err)
+	}
+}
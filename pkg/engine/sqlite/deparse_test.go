@@ -0,0 +1,65 @@
+package sqlite
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stephenwithav/sqlc/pkg/sql/ast"
+)
+
+func TestDeparse(t *testing.T) {
+	p := NewParser()
+	for _, tc := range []struct {
+		in  string
+		out string
+	}{
+		{
+			"select id, name from authors where id = ? limit 1",
+			"SELECT id, name FROM authors WHERE id = ? LIMIT 1",
+		},
+		{
+			"select id from main.authors where name = 'it''s' order by id desc, name asc limit 3 offset 2",
+			"SELECT id FROM main.authors WHERE name = 'it''s' ORDER BY id DESC, name ASC LIMIT 3 OFFSET 2",
+		},
+		{
+			"select * from authors where name like 'a%' and (id in (1, 2) or id = ?) and bio is not null",
+			"SELECT * FROM authors WHERE name LIKE 'a%' AND (id IN (1, 2) OR id = ?) AND bio IS NOT NULL",
+		},
+		{
+			"insert into authors (name, bio) values (?, null) on conflict (name) do update set bio = excluded.bio returning *",
+			"INSERT INTO authors (name, bio) VALUES (?, NULL) ON CONFLICT (name) DO UPDATE SET bio = excluded.bio RETURNING *",
+		},
+		{
+			"update authors set name = ? where id = ?",
+			"UPDATE authors SET name = ? WHERE id = ?",
+		},
+		{
+			"select id, row_number() over (partition by name order by id) from authors",
+			"SELECT id, row_number() OVER (PARTITION BY name ORDER BY id) FROM authors",
+		},
+	} {
+		stmts, err := p.Parse(strings.NewReader(tc.in))
+		if err != nil {
+			t.Fatal(err)
+		}
+		out, err := ast.Format(stmts[0].Raw.Stmt, p)
+		if err != nil {
+			t.Errorf("%s: %s", tc.in, err)
+			continue
+		}
+		if out != tc.out {
+			t.Errorf("deparse of %q\n got: %s\nwant: %s", tc.in, out, tc.out)
+		}
+		again, err := p.Parse(strings.NewReader(out))
+		if err != nil {
+			t.Errorf("%s: %s", out, err)
+			continue
+		}
+		if got, err := ast.Format(again[0].Raw.Stmt, p); err != nil || got != out {
+			t.Errorf("deparse of %q is not stable: %s %v", out, got, err)
+		}
+		if !sameTokens(tokens(tc.in), tokens(out)) {
+			t.Errorf("deparse of %q changed its meaning: %s", tc.in, out)
+		}
+	}
+}
@@ -0,0 +1,50 @@
+package sqlite
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/stephenwithav/sqlc/pkg/sql/ast"
+)
+
+func (p *Parser) QuoteIdent(s string) string {
+	if p.IsReservedKeyword(s) || !isSimpleIdent(s) {
+		return `"` + strings.ReplaceAll(s, `"`, `""`) + `"`
+	}
+	return s
+}
+
+func isSimpleIdent(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i, r := range s {
+		switch {
+		case r == '_' || ('a' <= r && r <= 'z') || ('A' <= r && r <= 'Z'):
+		case i > 0 && '0' <= r && r <= '9':
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+func (p *Parser) TypeName(ns, name string) string {
+	return name
+}
+
+func (p *Parser) Param(n int) string {
+	return "?"
+}
+
+func (p *Parser) Cast(arg, typeName string) string {
+	return fmt.Sprintf("CAST(%s AS %s)", arg, typeName)
+}
+
+func (p *Parser) OnDuplicateKey() bool {
+	return false
+}
+
+func (p *Parser) FuncCall(n *ast.FuncCall, args []string) (string, bool) {
+	return "", false
+}
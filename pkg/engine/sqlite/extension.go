@@ -0,0 +1,282 @@
+package sqlite
+
+import (
+	"github.com/stephenwithav/sqlc/pkg/sql/ast"
+	"github.com/stephenwithav/sqlc/pkg/sql/catalog"
+)
+
+// Functions which the bundled library is not built with, and table-valued
+// functions, which pragma_function_list doesn't report:
+//
+//	https://www.sqlite.org/lang_corefunc.html#soundex
+//	https://www.sqlite.org/lang_corefunc.html#sqlite_offset
+//	https://www.sqlite.org/fts5.html#_auxiliary_functions_
+//	https://www.sqlite.org/json1.html#jeach
+//	https://www.sqlite.org/pragma.html#pragfunc
+var funcsExtension = []*catalog.Function{
+	{
+		Name: "soundex",
+		Args: []*catalog.Argument{
+			{
+				Type: &ast.TypeName{Name: "text"},
+			},
+		},
+		ReturnType: &ast.TypeName{Name: "text"},
+	},
+	{
+		Name: "sqlite_offset",
+		Args: []*catalog.Argument{
+			{
+				Type: &ast.TypeName{Name: "any"},
+			},
+		},
+		ReturnType:         &ast.TypeName{Name: "integer"},
+		ReturnTypeNullable: true,
+	},
+
+	// FTS5 Auxiliary Functions
+	{
+		Name: "bm25",
+		Args: []*catalog.Argument{
+			{
+				Type: &ast.TypeName{Name: "text"},
+			},
+			{
+				Type: &ast.TypeName{Name: "real"},
+				Mode: ast.FuncParamVariadic,
+			},
+		},
+		ReturnType: &ast.TypeName{Name: "real"},
+	},
+	{
+		Name: "highlight",
+		Args: []*catalog.Argument{
+			{
+				Type: &ast.TypeName{Name: "text"},
+			},
+			{
+				Type: &ast.TypeName{Name: "integer"},
+			},
+			{
+				Type: &ast.TypeName{Name: "text"},
+			},
+			{
+				Type: &ast.TypeName{Name: "text"},
+			},
+		},
+		ReturnType:         &ast.TypeName{Name: "text"},
+		ReturnTypeNullable: true,
+	},
+	{
+		Name: "snippet",
+		Args: []*catalog.Argument{
+			{
+				Type: &ast.TypeName{Name: "text"},
+			},
+			{
+				Type: &ast.TypeName{Name: "integer"},
+			},
+			{
+				Type: &ast.TypeName{Name: "text"},
+			},
+			{
+				Type: &ast.TypeName{Name: "text"},
+			},
+			{
+				Type: &ast.TypeName{Name: "text"},
+			},
+			{
+				Type: &ast.TypeName{Name: "integer"},
+			},
+		},
+		ReturnType:         &ast.TypeName{Name: "text"},
+		ReturnTypeNullable: true,
+	},
+
+	// Table-valued Functions
+	{
+		Name: "json_each",
+		Args: []*catalog.Argument{
+			{
+				Name: "json",
+				Type: &ast.TypeName{Name: "any"},
+			},
+			{
+				Name:       "root",
+				Type:       &ast.TypeName{Name: "text"},
+				HasDefault: true,
+			},
+			{
+				Name: "key",
+				Type: &ast.TypeName{Name: "any"},
+				Mode: ast.FuncParamTable,
+			},
+			{
+				Name: "value",
+				Type: &ast.TypeName{Name: "any"},
+				Mode: ast.FuncParamTable,
+			},
+			{
+				Name: "type",
+				Type: &ast.TypeName{Name: "text"},
+				Mode: ast.FuncParamTable,
+			},
+			{
+				Name: "atom",
+				Type: &ast.TypeName{Name: "any"},
+				Mode: ast.FuncParamTable,
+			},
+			{
+				Name: "id",
+				Type: &ast.TypeName{Name: "integer"},
+				Mode: ast.FuncParamTable,
+			},
+			{
+				Name: "parent",
+				Type: &ast.TypeName{Name: "integer"},
+				Mode: ast.FuncParamTable,
+			},
+			{
+				Name: "fullkey",
+				Type: &ast.TypeName{Name: "text"},
+				Mode: ast.FuncParamTable,
+			},
+			{
+				Name: "path",
+				Type: &ast.TypeName{Name: "text"},
+				Mode: ast.FuncParamTable,
+			},
+		},
+		ReturnType: &ast.TypeName{Name: "record"},
+	},
+	{
+		Name: "json_tree",
+		Args: []*catalog.Argument{
+			{
+				Name: "json",
+				Type: &ast.TypeName{Name: "any"},
+			},
+			{
+				Name:       "root",
+				Type:       &ast.TypeName{Name: "text"},
+				HasDefault: true,
+			},
+			{
+				Name: "key",
+				Type: &ast.TypeName{Name: "any"},
+				Mode: ast.FuncParamTable,
+			},
+			{
+				Name: "value",
+				Type: &ast.TypeName{Name: "any"},
+				Mode: ast.FuncParamTable,
+			},
+			{
+				Name: "type",
+				Type: &ast.TypeName{Name: "text"},
+				Mode: ast.FuncParamTable,
+			},
+			{
+				Name: "atom",
+				Type: &ast.TypeName{Name: "any"},
+				Mode: ast.FuncParamTable,
+			},
+			{
+				Name: "id",
+				Type: &ast.TypeName{Name: "integer"},
+				Mode: ast.FuncParamTable,
+			},
+			{
+				Name: "parent",
+				Type: &ast.TypeName{Name: "integer"},
+				Mode: ast.FuncParamTable,
+			},
+			{
+				Name: "fullkey",
+				Type: &ast.TypeName{Name: "text"},
+				Mode: ast.FuncParamTable,
+			},
+			{
+				Name: "path",
+				Type: &ast.TypeName{Name: "text"},
+				Mode: ast.FuncParamTable,
+			},
+		},
+		ReturnType: &ast.TypeName{Name: "record"},
+	},
+	{
+		Name: "pragma_index_list",
+		Args: []*catalog.Argument{
+			{
+				Name: "arg",
+				Type: &ast.TypeName{Name: "text"},
+			},
+			{
+				Name: "seq",
+				Type: &ast.TypeName{Name: "integer"},
+				Mode: ast.FuncParamTable,
+			},
+			{
+				Name: "name",
+				Type: &ast.TypeName{Name: "text"},
+				Mode: ast.FuncParamTable,
+			},
+			{
+				Name: "unique",
+				Type: &ast.TypeName{Name: "integer"},
+				Mode: ast.FuncParamTable,
+			},
+			{
+				Name: "origin",
+				Type: &ast.TypeName{Name: "text"},
+				Mode: ast.FuncParamTable,
+			},
+			{
+				Name: "partial",
+				Type: &ast.TypeName{Name: "integer"},
+				Mode: ast.FuncParamTable,
+			},
+		},
+		ReturnType: &ast.TypeName{Name: "record"},
+	},
+	{
+		Name: "pragma_table_info",
+		Args: []*catalog.Argument{
+			{
+				Name: "arg",
+				Type: &ast.TypeName{Name: "text"},
+			},
+			{
+				Name: "cid",
+				Type: &ast.TypeName{Name: "integer"},
+				Mode: ast.FuncParamTable,
+			},
+			{
+				Name: "name",
+				Type: &ast.TypeName{Name: "text"},
+				Mode: ast.FuncParamTable,
+			},
+			{
+				Name: "type",
+				Type: &ast.TypeName{Name: "text"},
+				Mode: ast.FuncParamTable,
+			},
+			{
+				Name: "notnull",
+				Type: &ast.TypeName{Name: "integer"},
+				Mode: ast.FuncParamTable,
+			},
+			{
+				Name: "dflt_value",
+				Type: &ast.TypeName{Name: "any"},
+				Mode: ast.FuncParamTable,
+			},
+			{
+				Name: "pk",
+				Type: &ast.TypeName{Name: "integer"},
+				Mode: ast.FuncParamTable,
+			},
+		},
+		ReturnType: &ast.TypeName{Name: "record"},
+	},
+}
@@ -0,0 +1,88 @@
+package sqlite
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/stephenwithav/sqlc/pkg/sql/ast"
+)
+
+// Format prints a single statement from its syntax tree. The conversion to
+// the tree does not keep every detail of a statement, so the result is
+// rejected unless it has the same tokens as the input, in the same order.
+func (p *Parser) Format(sql string) (string, error) {
+	stmts, err := p.parse(sql, true)
+	if err != nil {
+		return "", err
+	}
+	if len(stmts) != 1 {
+		return "", fmt.Errorf("expected one statement; got %d", len(stmts))
+	}
+	out, err := ast.Format(stmts[0].Raw.Stmt, p)
+	if err != nil {
+		return "", err
+	}
+	if !sameTokens(tokens(sql), tokens(out)) {
+		return "", errors.New("formatting changed the meaning of the statement")
+	}
+	return out, nil
+}
+
+// tokens splits SQL into words, quoted strings and single punctuation
+// characters. Words are upper cased.
+func tokens(sql string) []string {
+	var toks []string
+	for i := 0; i < len(sql); {
+		c := sql[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case c == '\'' || c == '"' || c == '`':
+			end := i + 1
+			for end < len(sql) {
+				if sql[end] == c {
+					if end+1 < len(sql) && sql[end+1] == c {
+						end += 2
+						continue
+					}
+					break
+				}
+				end++
+			}
+			if end < len(sql) {
+				end++
+			}
+			toks = append(toks, sql[i:end])
+			i = end
+		case isWordByte(c):
+			end := i
+			for end < len(sql) && isWordByte(sql[end]) {
+				end++
+			}
+			toks = append(toks, strings.ToUpper(sql[i:end]))
+			i = end
+		default:
+			toks = append(toks, sql[i:i+1])
+			i++
+		}
+	}
+	return toks
+}
+
+func isWordByte(c byte) bool {
+	return c == '_' || c == '$' || ('a' <= c && c <= 'z') || ('A' <= c && c <= 'Z') || ('0' <= c && c <= '9') || c >= 0x80
+}
+
+// sameTokens reports whether a and b hold the same tokens in the same order.
+func sameTokens(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
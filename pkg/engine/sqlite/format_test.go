@@ -0,0 +1,46 @@
+package sqlite
+
+import (
+	"io"
+	"os"
+	"testing"
+)
+
+func TestSameTokens(t *testing.T) {
+	for _, tc := range []struct {
+		a, b string
+		same bool
+	}{
+		{"select id from authors where id = ?", "SELECT id\nFROM   authors WHERE id = ?", true},
+		{"SELECT 1", "SELECT 2", false},
+		{"SELECT * FROM authors WHERE name = 'a'", "SELECT * FROM authors WHERE name = 'b'", false},
+		{"SELECT a FROM t", "SELECT a FROM t WHERE 1", false},
+		{"SELECT a FROM t WHERE b", "SELECT a FROM t", false},
+	} {
+		if got := sameTokens(tokens(tc.a), tokens(tc.b)); got != tc.same {
+			t.Errorf("sameTokens(%q, %q) = %v; want %v", tc.a, tc.b, got, tc.same)
+		}
+	}
+}
+
+func TestFormatSyntaxErrorIsQuiet(t *testing.T) {
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	stderr := os.Stderr
+	os.Stderr = w
+	_, ferr := NewParser().Format("SELECT FROM WHERE")
+	os.Stderr = stderr
+	w.Close()
+	printed, err := io.ReadAll(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ferr == nil {
+		t.Error("expected a syntax error")
+	}
+	if len(printed) > 0 {
+		t.Errorf("Format printed to stderr: %s", printed)
+	}
+}
@@ -0,0 +1,52 @@
+package sqlite
+
+import (
+	"github.com/stephenwithav/sqlc/pkg/sql/ast"
+	"github.com/stephenwithav/sqlc/pkg/sql/catalog"
+)
+
+func binaryOp(name, left, right, result string) *catalog.Operator {
+	return &catalog.Operator{
+		Name:       name,
+		Left:       &ast.TypeName{Name: left},
+		Right:      &ast.TypeName{Name: right},
+		ReturnType: &ast.TypeName{Name: result},
+	}
+}
+
+func prefixOp(name, right, result string) *catalog.Operator {
+	return &catalog.Operator{
+		Name:       name,
+		Right:      &ast.TypeName{Name: right},
+		ReturnType: &ast.TypeName{Name: result},
+	}
+}
+
+// builtinOperators returns the signatures of the built-in operators whose results
+// sqlc infers the types of. Columns keep the type names they are declared
+// with, so the usual spellings of the numeric types are declared, narrowest
+// first.
+func builtinOperators() []*catalog.Operator {
+	var ops []*catalog.Operator
+	for _, t := range []string{"integer", "bigint", "numeric", "decimal", "real", "float", "double"} {
+		for _, name := range []string{"+", "-", "*", "/", "%"} {
+			ops = append(ops, binaryOp(name, t, t, t))
+		}
+		ops = append(ops, prefixOp("-", t, t), prefixOp("+", t, t))
+		switch t {
+		case "integer", "bigint":
+			for _, name := range []string{"&", "|", "<<", ">>"} {
+				ops = append(ops, binaryOp(name, t, t, t))
+			}
+			ops = append(ops, prefixOp("~", t, t))
+		}
+	}
+	// JSON is stored as text, and ->> returns a value of any type
+	ops = append(ops,
+		binaryOp("->", "json", "any", "json"),
+		binaryOp("->", "any", "any", "text"),
+		binaryOp("->>", "json", "any", "text"),
+		binaryOp("||", "any", "any", "text"),
+	)
+	return ops
+}
@@ -4,11 +4,14 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"strings"
+	"unicode/utf8"
 
 	"github.com/antlr/antlr4/runtime/Go/antlr"
 
 	"github.com/stephenwithav/sqlc/pkg/engine/sqlite/parser"
 	"github.com/stephenwithav/sqlc/pkg/metadata"
+	"github.com/stephenwithav/sqlc/pkg/source"
 	"github.com/stephenwithav/sqlc/pkg/sql/ast"
 )
 
@@ -36,6 +39,11 @@ func NewParser() *Parser {
 }
 
 type Parser struct {
+	// NullablePrimaryKeys lets a primary key column hold NULL, as SQLite
+	// does, unless it is declared NOT NULL, is the INTEGER PRIMARY KEY
+	// alias of the rowid or belongs to a WITHOUT ROWID or STRICT table.
+	// Otherwise every primary key column is NOT NULL.
+	NullablePrimaryKeys bool
 }
 
 func (p *Parser) Parse(r io.Reader) ([]ast.Statement, error) {
@@ -43,11 +51,25 @@ func (p *Parser) Parse(r io.Reader) ([]ast.Statement, error) {
 	if err != nil {
 		return nil, err
 	}
-	input := antlr.NewInputStream(string(blob))
+	return p.parse(string(blob), false)
+}
+
+// parse converts the statements in sql. When quiet is set, syntax errors are
+// only returned and not also printed to standard error.
+func (p *Parser) parse(sql string, quiet bool) ([]ast.Statement, error) {
+	src, paramFuncs := hideParamFuncs(sql)
+	src, strict := hideStrict(src)
+	src, jsonOps := hideJSONOperators(src)
+	input := antlr.NewInputStream(src)
 	lexer := parser.NewSQLiteLexer(input)
 	stream := antlr.NewCommonTokenStream(lexer, 0)
 	pp := parser.NewSQLiteParser(stream)
 	el := &errorListener{}
+	if quiet {
+		lexer.RemoveErrorListeners()
+		pp.RemoveErrorListeners()
+		lexer.AddErrorListener(el)
+	}
 	pp.AddErrorListener(el)
 	// pp.BuildParseTrees = true
 	tree := pp.Parse()
@@ -67,7 +89,7 @@ func (p *Parser) Parse(r io.Reader) ([]ast.Statement, error) {
 		loc := 0
 
 		for _, stmt := range list.AllSql_stmt() {
-			converter := &cc{}
+			converter := &cc{paramFuncs: paramFuncs, strict: strict, jsonOps: jsonOps, nullablePKs: p.NullablePrimaryKeys}
 			out := converter.convert(stmt)
 			if _, ok := out.(*ast.TODO); ok {
 				continue
@@ -80,12 +102,185 @@ func (p *Parser) Parse(r io.Reader) ([]ast.Statement, error) {
 					StmtLen:      len,
 				},
 			})
-			loc = stmt.GetStop().GetStop() + 2
+			loc = nextStatement(stream, stmt.GetStop())
 		}
 	}
 	return stmts, nil
 }
 
+// nextStatement returns the location following the semicolon which ends the
+// statement, which is not the character after its last token when a table
+// option has been hidden.
+func nextStatement(stream *antlr.CommonTokenStream, stop antlr.Token) int {
+	for i := stop.GetTokenIndex() + 1; i < stream.Size(); i++ {
+		tok := stream.Get(i)
+		if tok.GetTokenType() == antlr.TokenEOF {
+			break
+		}
+		if tok.GetTokenType() == parser.SQLiteParserSCOL {
+			return tok.GetStop() + 1
+		}
+	}
+	return stop.GetStop() + 2
+}
+
+// The SQLite grammar has no schema-qualified function calls. hideParamFuncs
+// turns each sqlc.arg and sqlc.narg call into a call to a plain function,
+// padded so every character keeps its position, and returns the locations
+// of the calls with the name of the function.
+func hideParamFuncs(sql string) (string, map[int]string) {
+	calls := source.ParamFuncs(sql, NewParser().CommentSyntax())
+	if len(calls) == 0 {
+		return sql, nil
+	}
+	funcs := map[int]string{}
+	var b strings.Builder
+	last := 0
+	for _, call := range calls {
+		start, end := call[0], call[1]
+		name := "arg"
+		if strings.HasSuffix(strings.ToLower(strings.TrimRight(sql[start:end], "\"`")), "narg") {
+			name = "narg"
+		}
+		b.WriteString(sql[last:start])
+		marker := "sqlc_" + name
+		b.WriteString(marker)
+		b.WriteString(strings.Repeat(" ", utf8.RuneCountInString(sql[start:end])-len(marker)))
+		last = end
+		// ANTLR reports positions in runes
+		funcs[utf8.RuneCountInString(sql[:start])] = name
+	}
+	b.WriteString(sql[last:])
+	return b.String(), funcs
+}
+
+// The SQLite grammar has no STRICT table option. hideStrict blanks out the
+// option, with the comma separating it from WITHOUT ROWID, and returns the
+// locations of the closing parentheses of the column lists of STRICT tables.
+func hideStrict(sql string) (string, map[int]bool) {
+	lexer := parser.NewSQLiteLexer(antlr.NewInputStream(sql))
+	lexer.RemoveErrorListeners()
+	var stmt []antlr.Token
+	var blank [][2]int
+	strict := map[int]bool{}
+	for _, tok := range lexer.GetAllTokens() {
+		if tok.GetChannel() != antlr.TokenDefaultChannel {
+			continue
+		}
+		if tok.GetTokenType() != parser.SQLiteParserSCOL {
+			stmt = append(stmt, tok)
+			continue
+		}
+		if loc, spans := strictOption(stmt); len(spans) > 0 {
+			strict[loc] = true
+			blank = append(blank, spans...)
+		}
+		stmt = nil
+	}
+	if loc, spans := strictOption(stmt); len(spans) > 0 {
+		strict[loc] = true
+		blank = append(blank, spans...)
+	}
+	if len(blank) == 0 {
+		return sql, nil
+	}
+	// ANTLR reports positions in runes
+	src := []rune(sql)
+	for _, span := range blank {
+		for i := span[0]; i <= span[1]; i++ {
+			src[i] = ' '
+		}
+	}
+	return string(src), strict
+}
+
+// strictOption returns the location of the closing parenthesis of a CREATE
+// TABLE statement and the spans of the tokens of its STRICT option.
+func strictOption(stmt []antlr.Token) (int, [][2]int) {
+	if len(stmt) < 2 || stmt[0].GetTokenType() != parser.SQLiteParserCREATE_ {
+		return 0, nil
+	}
+	isTable := false
+	for _, tok := range stmt {
+		if tok.GetTokenType() == parser.SQLiteParserTABLE_ {
+			isTable = true
+		}
+		if tok.GetTokenType() == parser.SQLiteParserOPEN_PAR {
+			break
+		}
+	}
+	if !isTable {
+		return 0, nil
+	}
+	end := len(stmt) - 1
+	for end >= 0 && stmt[end].GetTokenType() != parser.SQLiteParserCLOSE_PAR {
+		end--
+	}
+	if end < 0 {
+		return 0, nil
+	}
+	// The table options follow the column list
+	opts := stmt[end+1:]
+	for i, tok := range opts {
+		if tok.GetTokenType() != parser.SQLiteParserIDENTIFIER || !strings.EqualFold(tok.GetText(), "strict") {
+			continue
+		}
+		span := [2]int{tok.GetStart(), tok.GetStop()}
+		switch {
+		case i > 0 && opts[i-1].GetTokenType() == parser.SQLiteParserCOMMA:
+			span[0] = opts[i-1].GetStart()
+		case i+1 < len(opts) && opts[i+1].GetTokenType() == parser.SQLiteParserCOMMA:
+			span[1] = opts[i+1].GetStop()
+		}
+		return stmt[end].GetStart(), [][2]int{span}
+	}
+	return 0, nil
+}
+
+// The SQLite grammar has no JSON operators. hideJSONOperators turns each ->
+// and ->> into the || operator, which has the same precedence, padded so
+// every character keeps its position, and returns the locations of the
+// operators.
+func hideJSONOperators(sql string) (string, map[int]string) {
+	lexer := parser.NewSQLiteLexer(antlr.NewInputStream(sql))
+	lexer.RemoveErrorListeners()
+	var toks []antlr.Token
+	for _, tok := range lexer.GetAllTokens() {
+		if tok.GetChannel() == antlr.TokenDefaultChannel {
+			toks = append(toks, tok)
+		}
+	}
+	ops := map[int]string{}
+	var src []rune
+	for i := 0; i+1 < len(toks); i++ {
+		minus, gt := toks[i], toks[i+1]
+		if minus.GetTokenType() != parser.SQLiteParserMINUS || gt.GetStart() != minus.GetStop()+1 {
+			continue
+		}
+		switch gt.GetTokenType() {
+		case parser.SQLiteParserGT:
+			ops[minus.GetStart()] = "->"
+		case parser.SQLiteParserGT2:
+			ops[minus.GetStart()] = "->>"
+		default:
+			continue
+		}
+		// ANTLR reports positions in runes
+		if src == nil {
+			src = []rune(sql)
+		}
+		src[minus.GetStart()] = '|'
+		src[gt.GetStart()] = '|'
+		if gt.GetStop() > gt.GetStart() {
+			src[gt.GetStop()] = ' '
+		}
+	}
+	if len(ops) == 0 {
+		return sql, nil
+	}
+	return string(src), ops
+}
+
 func (p *Parser) CommentSyntax() metadata.CommentSyntax {
 	return metadata.CommentSyntax{
 		Dash:      true,
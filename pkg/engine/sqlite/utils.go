@@ -1,6 +1,8 @@
 package sqlite
 
 import (
+	"strings"
+
 	"github.com/stephenwithav/sqlc/pkg/engine/sqlite/parser"
 	"github.com/stephenwithav/sqlc/pkg/sql/ast"
 )
@@ -30,12 +32,65 @@ func hasNotNullConstraint(checks []parser.IColumn_constraintContext) bool {
 		if !ok {
 			continue
 		}
-		if constraint.PRIMARY_() != nil && constraint.KEY_() != nil {
-			return true
-		}
 		if constraint.NOT_() != nil && constraint.NULL_() != nil {
 			return true
 		}
 	}
 	return false
 }
+
+func hasPrimaryKeyConstraint(checks []parser.IColumn_constraintContext) (bool, bool) {
+	for i := range checks {
+		constraint, ok := checks[i].(*parser.Column_constraintContext)
+		if !ok {
+			continue
+		}
+		if constraint.PRIMARY_() != nil && constraint.KEY_() != nil {
+			desc := constraint.Asc_desc() != nil && strings.EqualFold(constraint.Asc_desc().GetText(), "desc")
+			return true, desc
+		}
+	}
+	return false, false
+}
+
+// generatedKind returns 's' for stored and 'v' for virtual generated columns.
+// Generated columns are virtual unless declared otherwise.
+func generatedKind(checks []parser.IColumn_constraintContext) byte {
+	for i := range checks {
+		constraint, ok := checks[i].(*parser.Column_constraintContext)
+		if !ok || constraint.AS_() == nil {
+			continue
+		}
+		if constraint.STORED_() != nil {
+			return 's'
+		}
+		return 'v'
+	}
+	return 0
+}
+
+// Columns declared without a type have no affinity.
+func typeName(def *parser.Column_defContext) string {
+	if def.Type_name() == nil {
+		return "any"
+	}
+	return def.Type_name().GetText()
+}
+
+func columnDef(def *parser.Column_defContext) *ast.ColumnDef {
+	return &ast.ColumnDef{
+		Colname:   identifier(def.Column_name().GetText()),
+		TypeName:  &ast.TypeName{Name: typeName(def)},
+		IsNotNull: hasNotNullConstraint(def.AllColumn_constraint()),
+		Generated: generatedKind(def.AllColumn_constraint()),
+	}
+}
+
+func hiddenColumn(name, typ string, notNull bool) *ast.ColumnDef {
+	return &ast.ColumnDef{
+		Colname:   name,
+		TypeName:  &ast.TypeName{Name: typ},
+		IsNotNull: notNull,
+		IsHidden:  true,
+	}
+}
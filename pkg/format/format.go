@@ -0,0 +1,265 @@
+// Package format rewrites the SQL embedded in sqlc.yaml into a canonical
+// form.
+//
+// Every statement is printed by the engine's deparser, so keyword casing and
+// spacing are consistent, and then laid out with one clause per line.
+// Comments between statements, including the -- name: metadata, are kept.
+// Statements with comments inside them, and statements the engine cannot
+// print back to an equivalent statement, are left as written.
+package format
+
+import (
+	"bytes"
+	"sort"
+	"strings"
+
+	"github.com/stephenwithav/sqlc/pkg/compiler"
+	"github.com/stephenwithav/sqlc/pkg/config"
+	"github.com/stephenwithav/sqlc/pkg/metadata"
+)
+
+// Config formats the literal and folded block scalars holding inline SQL in a
+// configuration file. Folded blocks become literal ones, as folding would
+// join the formatted lines, and a -- name: comment would then swallow the
+// statement after it. The rest of the file is unchanged. Engines which
+// cannot format SQL are skipped.
+func Config(data []byte) ([]byte, error) {
+	conf, err := config.ParseConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	lines := strings.Split(string(data), "\n")
+
+	type replacement struct {
+		start, end int
+		lines      []string
+	}
+	var repls []replacement
+	for i, sql := range conf.SQL {
+		switch sql.Engine {
+		case config.EngineMySQL, config.EnginePostgreSQL, config.EngineSQLite:
+		default:
+			continue
+		}
+		parser := compiler.NewCompiler(sql, config.Combine(conf, sql)).Parser()
+		formatter, ok := parser.(compiler.Formatter)
+		if !ok {
+			continue
+		}
+		var values []string
+		var sources []*config.Source
+		for j, value := range sql.Schema {
+			values = append(values, value)
+			sources = append(sources, conf.SQL[i].SchemaSource(j))
+		}
+		for j, value := range sql.Queries {
+			values = append(values, value)
+			sources = append(sources, conf.SQL[i].QueriesSource(j))
+		}
+		for k, src := range sources {
+			if src == nil || !src.IsBlock() {
+				continue
+			}
+			start, end := blockLines(lines, *src)
+			if src.IsFolded() {
+				indicator := []byte(lines[src.Line-1])
+				indicator[src.Column-1] = '|'
+				lines[src.Line-1] = string(indicator)
+			}
+			out := SQL(formatter, parser.CommentSyntax(), values[k])
+			var indented []string
+			for _, line := range strings.Split(strings.TrimSuffix(out, "\n"), "\n") {
+				if line != "" {
+					line = strings.Repeat(" ", src.Indent) + line
+				}
+				indented = append(indented, line)
+			}
+			repls = append(repls, replacement{start, end, indented})
+		}
+	}
+
+	// Replace from the bottom up so earlier line numbers stay valid
+	sort.Slice(repls, func(i, j int) bool { return repls[i].start > repls[j].start })
+	for _, r := range repls {
+		tail := append([]string{}, lines[r.end:]...)
+		lines = append(append(lines[:r.start], r.lines...), tail...)
+	}
+	return []byte(strings.Join(lines, "\n")), nil
+}
+
+// blockLines returns the 0-based range of lines holding the text of a block
+// scalar, without trailing blank lines.
+func blockLines(lines []string, src config.Source) (int, int) {
+	start := src.Line
+	end := start
+	for i := start; i < len(lines); i++ {
+		line := lines[i]
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		if len(line)-len(strings.TrimLeft(line, " ")) < src.Indent {
+			break
+		}
+		end = i + 1
+	}
+	return start, end
+}
+
+// SQL formats a block of statements. It never fails: statements which cannot
+// be formatted are kept as written.
+func SQL(f compiler.Formatter, cs metadata.CommentSyntax, sql string) string {
+	var b strings.Builder
+	for i, stmt := range split(sql, cs) {
+		if i > 0 {
+			b.WriteString("\n")
+		}
+		for _, line := range stmt.comments {
+			b.WriteString(line)
+			b.WriteString("\n")
+		}
+		if stmt.body == "" {
+			continue
+		}
+		body := verbatim(stmt.body)
+		if !stmt.commented {
+			if out, err := f.Format(stmt.body); err == nil {
+				body = layout(out)
+			}
+		}
+		b.WriteString(body)
+		b.WriteString(";\n")
+	}
+	return b.String()
+}
+
+// verbatim trims trailing whitespace from every line of a statement.
+func verbatim(body string) string {
+	lines := strings.Split(strings.TrimSpace(body), "\n")
+	for i := range lines {
+		lines[i] = strings.TrimRight(lines[i], " \t\r")
+	}
+	return strings.Join(lines, "\n")
+}
+
+type stmt struct {
+	// Comment lines before the statement
+	comments []string
+
+	// The statement without its terminating semicolon
+	body string
+
+	// Whether the body contains comments
+	commented bool
+}
+
+// split divides a block into statements. Strings, quoted identifiers and
+// comments are skipped over, so a semicolon inside them does not end a
+// statement.
+func split(sql string, cs metadata.CommentSyntax) []stmt {
+	var stmts []stmt
+	var cur stmt
+	bodyStart := -1
+	blank := true // the current line is blank so far
+
+	addComment := func(text string) {
+		for _, line := range strings.Split(text, "\n") {
+			cur.comments = append(cur.comments, strings.TrimSpace(line))
+		}
+	}
+	finish := func(end int) {
+		if bodyStart >= 0 {
+			cur.body = sql[bodyStart:end]
+		}
+		cur.comments = tidyComments(cur.comments)
+		if cur.body != "" || len(cur.comments) > 0 {
+			stmts = append(stmts, cur)
+		}
+		cur = stmt{}
+		bodyStart = -1
+	}
+
+	for i := 0; i < len(sql); {
+		c := sql[i]
+		switch {
+		case c == '\n':
+			if bodyStart < 0 && blank {
+				cur.comments = append(cur.comments, "")
+			}
+			blank = true
+			i++
+			continue
+
+		case c == ' ' || c == '\t' || c == '\r':
+			i++
+			continue
+
+		case (cs.Dash && strings.HasPrefix(sql[i:], "--")) || (cs.Hash && c == '#'):
+			end := strings.IndexByte(sql[i:], '\n')
+			if end < 0 {
+				end = len(sql) - i
+			}
+			if bodyStart < 0 {
+				addComment(sql[i : i+end])
+			} else {
+				cur.commented = true
+			}
+			i += end
+
+		case cs.SlashStar && strings.HasPrefix(sql[i:], "/*"):
+			end := strings.Index(sql[i+2:], "*/")
+			if end < 0 {
+				end = len(sql) - i
+			} else {
+				end += 4
+			}
+			if bodyStart < 0 {
+				addComment(sql[i : i+end])
+			} else {
+				cur.commented = true
+			}
+			i += end
+
+		case c == ';':
+			finish(i)
+			i++
+
+		default:
+			if bodyStart < 0 {
+				bodyStart = i
+			}
+			switch {
+			case c == '\'' || c == '"' || c == '`':
+				i = skipQuoted(sql, i, c)
+			case c == '$' && !cs.Hash && dollarTag(sql[i:]) != "":
+				tag := dollarTag(sql[i:])
+				end := strings.Index(sql[i+len(tag):], tag)
+				if end < 0 {
+					i = len(sql)
+				} else {
+					i += len(tag) + end + len(tag)
+				}
+			default:
+				i++
+			}
+		}
+		blank = false
+	}
+	finish(len(sql))
+	return stmts
+}
+
+// tidyComments drops leading and trailing blank lines and collapses runs of
+// blank lines.
+func tidyComments(lines []string) []string {
+	var out []string
+	for _, line := range lines {
+		if line == "" && (len(out) == 0 || out[len(out)-1] == "") {
+			continue
+		}
+		out = append(out, line)
+	}
+	for len(out) > 0 && out[len(out)-1] == "" {
+		out = out[:len(out)-1]
+	}
+	return out
+}
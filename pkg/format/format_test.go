@@ -0,0 +1,269 @@
+package format
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/stephenwithav/sqlc/pkg/compiler"
+	"github.com/stephenwithav/sqlc/pkg/config"
+	"github.com/stephenwithav/sqlc/pkg/engine/dolphin"
+	"github.com/stephenwithav/sqlc/pkg/engine/postgresql"
+	"github.com/stephenwithav/sqlc/pkg/engine/sqlite"
+)
+
+func TestSQL(t *testing.T) {
+	pg := postgresql.NewParser()
+	mysql := dolphin.NewParser()
+	lite := sqlite.NewParser()
+	for _, tc := range []struct {
+		name   string
+		parser compiler.Formatter
+		mysql  bool
+		in     string
+		out    string
+	}{
+		{
+			name:   "postgresql query",
+			parser: pg,
+			in: `-- name: GetAuthor :one
+   select id, name from authors a   left join books b on b.author_id = a.id
+   where id = $1 and name = @name limit 1`,
+			out: `-- name: GetAuthor :one
+SELECT id, name
+FROM authors a
+LEFT JOIN books b ON b.author_id = a.id
+WHERE id = $1 AND name = @name
+LIMIT 1;
+`,
+		},
+		{
+			name:   "postgresql schema",
+			parser: pg,
+			in: `-- Authors write books;
+create table authors (id bigserial primary key, name text not null,
+  price numeric(10,2));
+
+
+/* done */`,
+			out: `-- Authors write books;
+CREATE TABLE authors (
+  id bigserial PRIMARY KEY,
+  name text NOT NULL,
+  price numeric(10, 2)
+);
+
+/* done */
+`,
+		},
+		{
+			name:   "postgresql upsert",
+			parser: pg,
+			in:     `insert into authors (name, bio) values ($1, $2) on conflict (name) do update set bio = excluded.bio returning *;`,
+			out: `INSERT INTO authors (name, bio)
+VALUES ($1, $2)
+ON CONFLICT (name) DO UPDATE SET bio = excluded.bio
+RETURNING *;
+`,
+		},
+		{
+			name:   "comment inside a statement",
+			parser: pg,
+			in: `-- name: ListAuthors :many
+SELECT *   -- everything
+FROM authors;   `,
+			out: `-- name: ListAuthors :many
+SELECT *   -- everything
+FROM authors;
+`,
+		},
+		{
+			name:   "semicolon in a string",
+			parser: pg,
+			in:     `select 'a;b' as x; select $$c;d$$ as y`,
+			out: `SELECT 'a;b' AS x;
+
+SELECT 'c;d' AS y;
+`,
+		},
+		{
+			name:   "mysql query",
+			parser: mysql,
+			mysql:  true,
+			in: `# name: CreateAuthor :execresult
+insert into authors (name,bio) values (?,?);
+-- name: ListAuthors :many
+select * from authors where name like concat('%', sqlc.arg(name)) order by name`,
+			out: `# name: CreateAuthor :execresult
+INSERT INTO authors (name, bio)
+VALUES (?, ?);
+
+-- name: ListAuthors :many
+SELECT *
+FROM authors
+WHERE name LIKE CONCAT('%', sqlc.arg(name))
+ORDER BY name;
+`,
+		},
+		{
+			name:   "sqlite query",
+			parser: lite,
+			in: `-- name: UpdateAuthor :exec
+update authors set name = ? where id = ?;
+
+-- name: ListAuthors :many
+select id, row_number() over (partition by name order by id) from authors where name = 'it''s' order by id desc limit 3;`,
+			out: `-- name: UpdateAuthor :exec
+UPDATE authors
+SET name = ?
+WHERE id = ?;
+
+-- name: ListAuthors :many
+SELECT id, row_number() OVER (PARTITION BY name ORDER BY id)
+FROM authors
+WHERE name = 'it''s'
+ORDER BY id DESC
+LIMIT 3;
+`,
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			cs := pg.CommentSyntax()
+			if tc.mysql {
+				cs = mysql.CommentSyntax()
+			}
+			got := SQL(tc.parser, cs, tc.in)
+			if diff := cmp.Diff(tc.out, got); diff != "" {
+				t.Errorf("format differs (-want +got):\n%s", diff)
+			}
+			if again := SQL(tc.parser, cs, got); again != got {
+				t.Errorf("format is not idempotent:\n%s", again)
+			}
+		})
+	}
+}
+
+func TestConfig(t *testing.T) {
+	in := `version: "2"
+sql:
+  - engine: "postgresql"
+    schema: |
+        create table authors (id bigserial primary key, name text not null);
+    queries: |-
+      -- name: GetAuthor :one
+         select * from authors where id = $1 limit 1;
+
+      -- name: DeleteAuthor :exec
+         delete from authors where id = $1;
+
+    gen:
+      go:
+        package: "db"
+        out: "db"
+  - engine: "sqlite"
+    queries: "select  1"
+    schema: |
+      create table t (id integer);
+    gen:
+      go:
+        package: "lite"
+        out: "lite"
+`
+	want := `version: "2"
+sql:
+  - engine: "postgresql"
+    schema: |
+        CREATE TABLE authors (
+          id bigserial PRIMARY KEY,
+          name text NOT NULL
+        );
+    queries: |-
+      -- name: GetAuthor :one
+      SELECT *
+      FROM authors
+      WHERE id = $1
+      LIMIT 1;
+
+      -- name: DeleteAuthor :exec
+      DELETE FROM authors
+      WHERE id = $1;
+
+    gen:
+      go:
+        package: "db"
+        out: "db"
+  - engine: "sqlite"
+    queries: "select  1"
+    schema: |
+      CREATE TABLE t (
+        id integer
+      );
+    gen:
+      go:
+        package: "lite"
+        out: "lite"
+`
+	got, err := Config([]byte(in))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(want, string(got)); diff != "" {
+		t.Errorf("config differs (-want +got):\n%s", diff)
+	}
+
+	// Folded blocks become literal ones, or YAML would join the formatted
+	// lines back together
+	in = `version: "2"
+sql:
+  - engine: "postgresql"
+    schema: >
+      create table authors (id bigserial primary key, name text not null);
+    queries: >-
+      -- name: GetAuthor :one
+
+      select * from authors
+      where id = $1 limit 1;
+    gen:
+      go:
+        package: "db"
+        out: "db"
+`
+	want = `version: "2"
+sql:
+  - engine: "postgresql"
+    schema: |
+      CREATE TABLE authors (
+        id bigserial PRIMARY KEY,
+        name text NOT NULL
+      );
+    queries: |-
+      -- name: GetAuthor :one
+      SELECT *
+      FROM authors
+      WHERE id = $1
+      LIMIT 1;
+    gen:
+      go:
+        package: "db"
+        out: "db"
+`
+	got, err = Config([]byte(in))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(want, string(got)); diff != "" {
+		t.Errorf("config differs (-want +got):\n%s", diff)
+	}
+
+	// The query must survive a round trip through the YAML parser
+	conf, err := config.ParseConfig(bytes.NewReader(got))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if q := conf.SQL[0].Queries[0]; !strings.Contains(q, "\nSELECT *\n") {
+		t.Errorf("query lost in the formatted config: %q", q)
+	}
+}
@@ -0,0 +1,305 @@
+package format
+
+import (
+	"strings"
+)
+
+type tokenKind int
+
+const (
+	tokSpace tokenKind = iota
+	tokWord
+	tokQuoted
+	tokPunct
+)
+
+type token struct {
+	kind tokenKind
+	text string
+}
+
+func (t token) is(punct string) bool {
+	return t.kind == tokPunct && t.text == punct
+}
+
+func (t token) upper() string {
+	if t.kind != tokWord {
+		return ""
+	}
+	return strings.ToUpper(t.text)
+}
+
+func isWordByte(c byte) bool {
+	return c == '_' || c == '$' || c == '.' || c == '@' ||
+		('a' <= c && c <= 'z') || ('A' <= c && c <= 'Z') || ('0' <= c && c <= '9') || c >= 0x80
+}
+
+// lex splits a single-line statement, as printed by a deparser, into tokens.
+func lex(sql string) []token {
+	var toks []token
+	for i := 0; i < len(sql); {
+		c := sql[i]
+		start := i
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			for i < len(sql) && strings.IndexByte(" \t\n\r", sql[i]) >= 0 {
+				i++
+			}
+			toks = append(toks, token{tokSpace, " "})
+		case c == '\'' || c == '"' || c == '`':
+			i = skipQuoted(sql, i, c)
+			toks = append(toks, token{tokQuoted, sql[start:i]})
+		case c == '$' && dollarTag(sql[i:]) != "":
+			tag := dollarTag(sql[i:])
+			end := strings.Index(sql[i+len(tag):], tag)
+			if end < 0 {
+				i = len(sql)
+			} else {
+				i += len(tag) + end + len(tag)
+			}
+			toks = append(toks, token{tokQuoted, sql[start:i]})
+		case isWordByte(c):
+			for i < len(sql) && isWordByte(sql[i]) {
+				i++
+			}
+			toks = append(toks, token{tokWord, sql[start:i]})
+		default:
+			i++
+			toks = append(toks, token{tokPunct, sql[start:i]})
+		}
+	}
+	return toks
+}
+
+// skipQuoted returns the offset just past the quoted string starting at i.
+// A doubled quote character is an escaped quote.
+func skipQuoted(sql string, i int, quote byte) int {
+	for i++; i < len(sql); i++ {
+		if sql[i] != quote {
+			continue
+		}
+		if i+1 < len(sql) && sql[i+1] == quote {
+			i++
+			continue
+		}
+		return i + 1
+	}
+	return len(sql)
+}
+
+// dollarTag returns the opening tag of a PostgreSQL dollar-quoted string, or
+// the empty string. $1 is a parameter, not a tag.
+func dollarTag(s string) string {
+	for i := 1; i < len(s); i++ {
+		switch c := s[i]; {
+		case c == '$':
+			return s[:i+1]
+		case c == '_' || ('a' <= c && c <= 'z') || ('A' <= c && c <= 'Z'):
+		case '0' <= c && c <= '9' && i > 1:
+		default:
+			return ""
+		}
+	}
+	return ""
+}
+
+// Clauses of a query which start on their own line
+var clauses = map[string]bool{
+	"FROM":      true,
+	"WHERE":     true,
+	"GROUP":     true,
+	"HAVING":    true,
+	"WINDOW":    true,
+	"ORDER":     true,
+	"LIMIT":     true,
+	"OFFSET":    true,
+	"VALUES":    true,
+	"SET":       true,
+	"RETURNING": true,
+	"UNION":     true,
+	"INTERSECT": true,
+	"EXCEPT":    true,
+	"SELECT":    true,
+	"INSERT":    true,
+	"UPDATE":    true,
+	"DELETE":    true,
+}
+
+// Clauses which stay on the line of the keyword before them
+var clauseExceptions = map[string]map[string]bool{
+	"FROM":   {"DISTINCT": true, "DELETE": true},
+	"GROUP":  {"WITHIN": true},
+	"VALUES": {"DEFAULT": true},
+	"SELECT": {"UNION": true, "INTERSECT": true, "EXCEPT": true, "ALL": true, "DISTINCT": true},
+	"UPDATE": {"DO": true, "FOR": true, "KEY": true, "ON": true},
+	"DELETE": {"ON": true},
+	"INSERT": {"ON": true},
+	"SET":    {"UPDATE": true},
+}
+
+var joinWords = map[string]bool{
+	"LEFT":    true,
+	"RIGHT":   true,
+	"FULL":    true,
+	"INNER":   true,
+	"OUTER":   true,
+	"CROSS":   true,
+	"NATURAL": true,
+	"JOIN":    true,
+}
+
+// layout places the clauses of a deparsed statement on their own lines and
+// the definitions in a CREATE TABLE statement on indented lines.
+func layout(sql string) string {
+	toks := normalizeSpace(lex(strings.TrimSpace(sql)))
+	if len(toks) == 0 {
+		return ""
+	}
+	switch toks[0].upper() {
+	case "SELECT", "INSERT", "UPDATE", "DELETE", "WITH", "VALUES":
+		return render(breakClauses(toks))
+	case "CREATE":
+		if isCreateTable(toks) {
+			return render(breakDefinitions(toks))
+		}
+	}
+	return render(toks)
+}
+
+// normalizeSpace collapses whitespace and puts a single space after every
+// comma.
+func normalizeSpace(toks []token) []token {
+	var out []token
+	for i, t := range toks {
+		if t.kind == tokSpace && i+1 < len(toks) && toks[i+1].is(",") {
+			continue
+		}
+		out = append(out, t)
+		if t.is(",") && i+1 < len(toks) && toks[i+1].kind != tokSpace {
+			out = append(out, token{tokSpace, " "})
+		}
+	}
+	return out
+}
+
+func render(toks []token) string {
+	var b strings.Builder
+	for _, t := range toks {
+		b.WriteString(t.text)
+	}
+	return b.String()
+}
+
+func prevWord(toks []token, i int) string {
+	for i--; i >= 0; i-- {
+		if toks[i].kind == tokWord {
+			return toks[i].upper()
+		}
+		if toks[i].kind != tokSpace {
+			return ""
+		}
+	}
+	return ""
+}
+
+// startsJoin reports whether the word at i begins a join, such as LEFT OUTER
+// JOIN. LEFT and RIGHT are also the names of functions.
+func startsJoin(toks []token, i int) bool {
+	if joinWords[prevWord(toks, i)] {
+		return false
+	}
+	for ; i < len(toks); i++ {
+		switch {
+		case toks[i].kind == tokSpace:
+		case toks[i].upper() == "JOIN":
+			return true
+		case joinWords[toks[i].upper()]:
+		default:
+			return false
+		}
+	}
+	return false
+}
+
+func breakClauses(toks []token) []token {
+	depth := 0
+	for i, t := range toks {
+		switch {
+		case t.is("("):
+			depth++
+		case t.is(")"):
+			depth--
+		}
+		if depth != 0 || i == 0 || t.kind != tokWord || toks[i-1].kind != tokSpace {
+			continue
+		}
+		word := t.upper()
+		brk := joinWords[word] && startsJoin(toks, i)
+		if clauses[word] && !clauseExceptions[word][prevWord(toks, i)] {
+			brk = true
+		}
+		if word == "ON" && i+2 < len(toks) {
+			// ON CONFLICT and ON DUPLICATE KEY UPDATE
+			switch toks[i+2].upper() {
+			case "CONFLICT", "DUPLICATE":
+				brk = true
+			}
+		}
+		if brk {
+			toks[i-1] = token{tokSpace, "\n"}
+		}
+	}
+	return toks
+}
+
+func isCreateTable(toks []token) bool {
+	table := false
+	for _, t := range toks {
+		if t.is("(") {
+			return table
+		}
+		switch t.upper() {
+		case "TABLE":
+			table = true
+		case "AS", "SELECT":
+			return false
+		}
+	}
+	return false
+}
+
+func breakDefinitions(toks []token) []token {
+	var out []token
+	depth := 0
+	done := false
+	for i := 0; i < len(toks); i++ {
+		t := toks[i]
+		switch {
+		case done:
+		case t.is("("):
+			depth++
+			if depth == 1 {
+				out = append(out, t, token{tokSpace, "\n  "})
+				if i+1 < len(toks) && toks[i+1].kind == tokSpace {
+					i++
+				}
+				continue
+			}
+		case t.is(")"):
+			depth--
+			if depth == 0 {
+				out = append(out, token{tokSpace, "\n"}, t)
+				done = true
+				continue
+			}
+		case t.is(",") && depth == 1:
+			out = append(out, t, token{tokSpace, "\n  "})
+			if i+1 < len(toks) && toks[i+1].kind == tokSpace {
+				i++
+			}
+			continue
+		}
+		out = append(out, t)
+	}
+	return out
+}
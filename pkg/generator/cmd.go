@@ -4,6 +4,7 @@ import (
 	"context"
 	"io"
 
+	"github.com/stephenwithav/sqlc/pkg/diagnostic"
 	"github.com/stephenwithav/sqlc/pkg/plugin"
 	"github.com/stephenwithav/template"
 )
@@ -11,6 +12,16 @@ import (
 type Option struct {
 	templateOptions  []template.Option
 	filesPerTemplate map[string]string
+
+	// DiagnosticFormat selects how errors are written to standard error.
+	// The zero value writes plain text.
+	DiagnosticFormat diagnostic.Format
+
+	// ConfigPath is the path the configuration was read from. Errors inside
+	// inline SQL are reported against it and database files are found
+	// relative to its directory. When it is empty errors name sqlc.yaml and
+	// database files are found from the working directory.
+	ConfigPath string
 }
 
 // SQLToGo transforms a sqlc.yaml-formatted io.Reader into the appropriate Go
@@ -18,6 +18,7 @@ import (
 	"github.com/stephenwithav/sqlc/pkg/compiler"
 	"github.com/stephenwithav/sqlc/pkg/config"
 	"github.com/stephenwithav/sqlc/pkg/debug"
+	"github.com/stephenwithav/sqlc/pkg/diagnostic"
 	"github.com/stephenwithav/sqlc/pkg/multierr"
 	"github.com/stephenwithav/sqlc/pkg/opts"
 	"github.com/stephenwithav/sqlc/pkg/plugin"
@@ -60,8 +61,9 @@ func findPlugin(conf config.Config, name string) (*config.Plugin, error) {
 	return nil, fmt.Errorf("plugin not found")
 }
 
-func readConfig(stderr io.Writer, configSource io.Reader) (*config.Config, error) {
+func readConfig(stderr io.Writer, path string, configSource io.Reader) (*config.Config, error) {
 	conf, err := config.ParseConfig(configSource)
+	conf.Path = path
 	if err != nil {
 		switch err {
 		case config.ErrMissingVersion:
@@ -70,6 +72,8 @@ func readConfig(stderr io.Writer, configSource io.Reader) (*config.Config, error
 			fmt.Fprintf(stderr, errMessageUnknownVersion)
 		case config.ErrNoPackages:
 			fmt.Fprintf(stderr, errMessageNoPackages)
+		default:
+			fmt.Fprintf(stderr, "error parsing %s: %s\n", conf.Filename(), err)
 		}
 		return nil, err
 	}
@@ -97,8 +101,15 @@ func Generate(ctx context.Context, configSource io.Reader, options *Option) (map
 	// could be a bytes.Reader or strings.NewReader. configPath is really
 	// unnecessary.
 
+	if options == nil {
+		options = &Option{}
+	}
 	verifyOptions(options)
-	conf, err := readConfig(os.Stderr, configSource)
+	data, err := io.ReadAll(configSource)
+	if err != nil {
+		return nil, nil, err
+	}
+	conf, err := readConfig(os.Stderr, options.ConfigPath, bytes.NewReader(data))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -133,11 +144,13 @@ func Generate(ctx context.Context, configSource io.Reader, options *Option) (map
 	grp.SetLimit(runtime.GOMAXPROCS(0))
 
 	stderrs := make([]bytes.Buffer, len(pairs))
+	diags := make([][]diagnostic.Diagnostic, len(pairs))
 	codeGenReqs := make([]*plugin.CodeGenRequest, len(pairs))
 
 	for i, pair := range pairs {
 		sql := pair
 		errout := &stderrs[i]
+		diag := &diags[i]
 
 		grp.Go(func() error {
 			combo := config.Combine(*conf, sql.SQL)
@@ -177,8 +190,9 @@ func Generate(ctx context.Context, configSource io.Reader, options *Option) (map
 			packageRegion := trace.StartRegion(gctx, "package")
 			trace.Logf(gctx, "", "name=%s plugin=%s", name, lang)
 
-			result, failed := parse(gctx, sql.SQL, combo, parseOpts, errout)
-			if failed {
+			result, err := parse(gctx, sql.SQL, combo, parseOpts, errout)
+			if err != nil {
+				*diag = diagnostic.FromError(err, conf.Filename(), string(data))
 				packageRegion.End()
 				errored = true
 				return nil
@@ -189,6 +203,12 @@ func Generate(ctx context.Context, configSource io.Reader, options *Option) (map
 			if err != nil {
 				fmt.Fprintf(errout, "# package %s\n", name)
 				fmt.Fprintf(errout, "error generating code: %s\n", err)
+				*diag = []diagnostic.Diagnostic{{
+					Filename: conf.Filename(),
+					Severity: diagnostic.SeverityError,
+					Rule:     diagnostic.DefaultRule,
+					Message:  fmt.Sprintf("package %s: error generating code: %s", name, err),
+				}}
 				errored = true
 				packageRegion.End()
 				return nil
@@ -216,6 +236,16 @@ func Generate(ctx context.Context, configSource io.Reader, options *Option) (map
 		return nil, nil, err
 	}
 	if errored {
+		if f := options.DiagnosticFormat; f != "" && f != diagnostic.FormatText {
+			var all []diagnostic.Diagnostic
+			for _, d := range diags {
+				all = append(all, d...)
+			}
+			if err := diagnostic.Write(os.Stderr, f, all); err != nil {
+				return nil, nil, err
+			}
+			return nil, nil, fmt.Errorf("errored")
+		}
 		for i, _ := range stderrs {
 			if _, err := io.Copy(os.Stderr, &stderrs[i]); err != nil {
 				return nil, nil, err
@@ -226,26 +256,38 @@ func Generate(ctx context.Context, configSource io.Reader, options *Option) (map
 	return output, codeGenReqs, nil
 }
 
-func parse(ctx context.Context, sql config.SQL, combo config.CombinedSettings, parserOpts opts.Parser, stderr io.Writer) (*compiler.Result, bool) {
+func parse(ctx context.Context, sql config.SQL, combo config.CombinedSettings, parserOpts opts.Parser, stderr io.Writer) (*compiler.Result, error) {
 	defer trace.StartRegion(ctx, "parse").End()
 	c := compiler.NewCompiler(sql, combo)
 	if err := c.ParseCatalog(sql.Schema); err != nil {
-		fmt.Fprintf(stderr, "error parsing schema: %s\n", err)
-		return nil, true
+		if parserErr, ok := err.(*multierr.Error); ok {
+			for _, fileErr := range parserErr.Errs() {
+				printFileErr(stderr, "", fileErr)
+			}
+		} else {
+			fmt.Fprintf(stderr, "error parsing schema: %s\n", err)
+		}
+		return nil, err
 	}
 	if parserOpts.Debug.DumpCatalog {
 		debug.Dump(c.Catalog())
 	}
 	if err := c.ParseQueries(sql.Queries, parserOpts); err != nil {
-		fmt.Fprintf(stderr, "error parsing queries: %s\n", err)
-		return nil, true
+		if parserErr, ok := err.(*multierr.Error); ok {
+			for _, fileErr := range parserErr.Errs() {
+				printFileErr(stderr, "", fileErr)
+			}
+		} else {
+			fmt.Fprintf(stderr, "error parsing queries: %s\n", err)
+		}
+		return nil, err
 	}
-	return c.Result(), false
+	return c.Result(), nil
 }
 
 func codegen(ctx context.Context, combo config.CombinedSettings, sql outPair, result *compiler.Result, options *Option) (string, *plugin.CodeGenResponse, *plugin.CodeGenRequest, error) {
 	defer trace.StartRegion(ctx, "codegen").End()
-	req := codeGenRequest(result, combo)
+	req := CodeGenRequest(result, combo)
 	// fmt.Printf("Queriez: %+v\n", req.GetQueries()[0].GetColumns())
 	out := combo.Go.Out
 	resp, err := golang.Generate(ctx, req, options.templateOptions, options.filesPerTemplate)
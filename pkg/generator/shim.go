@@ -128,6 +128,7 @@ func pluginCatalog(c *catalog.Catalog) *plugin.Catalog {
 	for _, s := range c.Schemas {
 		var enums []*plugin.Enum
 		var cts []*plugin.CompositeType
+		var domains []*plugin.Domain
 		for _, typ := range s.Types {
 			switch typ := typ.(type) {
 			case *catalog.Enum:
@@ -141,12 +142,28 @@ func pluginCatalog(c *catalog.Catalog) *plugin.Catalog {
 					Name:    typ.Name,
 					Comment: typ.Comment,
 				})
+			case *catalog.Domain:
+				domains = append(domains, &plugin.Domain{
+					Name:    typ.Name,
+					Comment: typ.Comment,
+					Type: &plugin.Identifier{
+						Catalog: typ.BaseType.Catalog,
+						Schema:  typ.BaseType.Schema,
+						Name:    typ.BaseType.Name,
+					},
+					NotNull: typ.NotNull,
+					IsArray: typ.IsArray,
+				})
 			}
 		}
 		var tables []*plugin.Table
 		for _, t := range s.Tables {
 			var columns []*plugin.Column
 			for _, c := range t.Columns {
+				// Hidden columns are not part of the rows of a table
+				if c.IsHidden {
+					continue
+				}
 				l := -1
 				if c.Length != nil {
 					l = *c.Length
@@ -158,10 +175,11 @@ func pluginCatalog(c *catalog.Catalog) *plugin.Catalog {
 						Schema:  c.Type.Schema,
 						Name:    c.Type.Name,
 					},
-					Comment: c.Comment,
-					NotNull: c.IsNotNull,
-					IsArray: c.IsArray,
-					Length:  int32(l),
+					Comment:   c.Comment,
+					NotNull:   c.IsNotNull,
+					IsArray:   c.IsArray,
+					Length:    int32(l),
+					Collation: c.Collation,
 					Table: &plugin.Identifier{
 						Catalog: t.Rel.Catalog,
 						Schema:  t.Rel.Schema,
@@ -169,14 +187,40 @@ func pluginCatalog(c *catalog.Catalog) *plugin.Catalog {
 					},
 				})
 			}
+			var indexes []*plugin.Index
+			for _, idx := range t.Indexes {
+				indexes = append(indexes, &plugin.Index{
+					Name:    idx.Name,
+					Columns: idx.Columns,
+					Unique:  idx.Unique,
+					Primary: idx.Primary,
+				})
+			}
+			var partition *plugin.Partition
+			if t.Partition != nil {
+				partition = &plugin.Partition{
+					Strategy: t.Partition.Strategy,
+					Columns:  t.Partition.Columns,
+				}
+			}
 			tables = append(tables, &plugin.Table{
 				Rel: &plugin.Identifier{
 					Catalog: t.Rel.Catalog,
 					Schema:  t.Rel.Schema,
 					Name:    t.Rel.Name,
 				},
-				Columns: columns,
-				Comment: t.Comment,
+				Columns:   columns,
+				Comment:   t.Comment,
+				Indexes:   indexes,
+				Partition: partition,
+			})
+		}
+		var sequences []*plugin.Identifier
+		for _, seq := range s.Sequences {
+			sequences = append(sequences, &plugin.Identifier{
+				Catalog: seq.Rel.Catalog,
+				Schema:  seq.Rel.Schema,
+				Name:    seq.Rel.Name,
 			})
 		}
 		schemas = append(schemas, &plugin.Schema{
@@ -185,6 +229,8 @@ func pluginCatalog(c *catalog.Catalog) *plugin.Catalog {
 			Tables:         tables,
 			Enums:          enums,
 			CompositeTypes: cts,
+			Domains:        domains,
+			Sequences:      sequences,
 		})
 	}
 	return &plugin.Catalog{
@@ -239,6 +285,7 @@ func pluginQueryColumn(c *compiler.Column) *plugin.Column {
 		NotNull:      c.NotNull,
 		IsArray:      c.IsArray,
 		Length:       int32(l),
+		Collation:    c.Collation,
 		IsNamedParam: c.IsNamedParam,
 		IsFuncCall:   c.IsFuncCall,
 	}
@@ -273,7 +320,9 @@ func pluginQueryParam(p compiler.Parameter) *plugin.Parameter {
 	}
 }
 
-func codeGenRequest(r *compiler.Result, settings config.CombinedSettings) *plugin.CodeGenRequest {
+// CodeGenRequest builds the request handed to code generators for a compiled
+// package.
+func CodeGenRequest(r *compiler.Result, settings config.CombinedSettings) *plugin.CodeGenRequest {
 	return &plugin.CodeGenRequest{
 		Settings:    pluginSettings(settings),
 		Catalog:     pluginCatalog(r.Catalog),
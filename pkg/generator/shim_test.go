@@ -0,0 +1,56 @@
+package generator
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	"github.com/stephenwithav/sqlc/pkg/plugin"
+	"github.com/stephenwithav/sqlc/pkg/sql/ast"
+	"github.com/stephenwithav/sqlc/pkg/sql/catalog"
+)
+
+func TestPluginCatalogIndexes(t *testing.T) {
+	c := catalog.New("public")
+	c.Schemas[0].Tables = []*catalog.Table{
+		{
+			Rel: &ast.TableName{Name: "events"},
+			Indexes: []*catalog.Index{
+				{Name: "events_pkey", Columns: []string{"id", "created_at"}, Unique: true, Primary: true},
+				{Name: "events_kind_idx", Columns: []string{"kind"}},
+			},
+			Partition: &catalog.Partition{Strategy: "range", Columns: []string{"created_at"}},
+		},
+		{
+			Rel: &ast.TableName{Name: "kinds"},
+		},
+	}
+	c.Schemas[0].Sequences = []*catalog.Sequence{
+		{Rel: &ast.TableName{Schema: "public", Name: "event_ids"}},
+	}
+
+	schema := pluginCatalog(c).Schemas[0]
+	want := &plugin.Schema{
+		Name: "public",
+		Tables: []*plugin.Table{
+			{
+				Rel: &plugin.Identifier{Name: "events"},
+				Indexes: []*plugin.Index{
+					{Name: "events_pkey", Columns: []string{"id", "created_at"}, Unique: true, Primary: true},
+					{Name: "events_kind_idx", Columns: []string{"kind"}},
+				},
+				Partition: &plugin.Partition{Strategy: "range", Columns: []string{"created_at"}},
+			},
+			{
+				Rel: &plugin.Identifier{Name: "kinds"},
+			},
+		},
+		Sequences: []*plugin.Identifier{
+			{Schema: "public", Name: "event_ids"},
+		},
+	}
+	if diff := cmp.Diff(want, schema, protocmp.Transform()); diff != "" {
+		t.Errorf("schema differs (-want +got):\n%s", diff)
+	}
+}
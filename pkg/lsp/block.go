@@ -0,0 +1,83 @@
+package lsp
+
+import (
+	"strings"
+
+	"github.com/stephenwithav/sqlc/pkg/config"
+)
+
+const (
+	kindSchema  = "schema"
+	kindQueries = "queries"
+)
+
+// A block is a single inline schema or queries entry in sqlc.yaml. It knows
+// where its SQL text starts in the configuration file so positions can be
+// translated in both directions.
+type block struct {
+	pkg   int
+	kind  string
+	value string
+	src   config.Source
+}
+
+func findBlocks(conf config.Config) []*block {
+	var blocks []*block
+	for i, sql := range conf.SQL {
+		for j, value := range sql.Schema {
+			// A database entry holds no SQL
+			if src := sql.SchemaSource(j); src != nil && !src.Database {
+				blocks = append(blocks, &block{pkg: i, kind: kindSchema, value: value, src: *src})
+			}
+		}
+		for j, value := range sql.Queries {
+			if src := sql.QueriesSource(j); src != nil {
+				blocks = append(blocks, &block{pkg: i, kind: kindQueries, value: value, src: *src})
+			}
+		}
+	}
+	return blocks
+}
+
+// toFile converts a 1-based line and column inside the SQL text into a
+// 0-based position in the configuration file.
+func (b *block) toFile(line, col int) Position {
+	l, c := b.src.Translate(line, col)
+	return Position{Line: l - 1, Character: c - 1}
+}
+
+// offsetToFile converts a byte offset in the SQL text into a position in the
+// configuration file.
+func (b *block) offsetToFile(offset int) Position {
+	if offset > len(b.value) {
+		offset = len(b.value)
+	}
+	head := b.value[:offset]
+	line := strings.Count(head, "\n") + 1
+	col := offset - strings.LastIndex(head, "\n")
+	return b.toFile(line, col)
+}
+
+// fromFile converts a position in the configuration file into a byte offset
+// in the SQL text. It reports false if the position is outside the block.
+func (b *block) fromFile(pos Position) (int, bool) {
+	line, col, ok := b.src.Untranslate(pos.Line+1, pos.Character+1)
+	lines := strings.SplitAfter(b.value, "\n")
+	if !ok || line < 1 || line > len(lines) {
+		return 0, false
+	}
+	if line == 1 && col < 1 {
+		return 0, false
+	}
+	if col < 1 {
+		col = 1
+	}
+	offset := 0
+	for _, l := range lines[:line-1] {
+		offset += len(l)
+	}
+	if n := len(strings.TrimRight(lines[line-1], "\n")); col-1 > n {
+		col = n + 1
+	}
+	return offset + col - 1, true
+}
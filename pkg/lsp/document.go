@@ -0,0 +1,508 @@
+package lsp
+
+import (
+	"fmt"
+	"net/url"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+
+	"github.com/stephenwithav/sqlc/pkg/codegen/golang"
+	"github.com/stephenwithav/sqlc/pkg/compiler"
+	"github.com/stephenwithav/sqlc/pkg/config"
+	"github.com/stephenwithav/sqlc/pkg/diagnostic"
+	"github.com/stephenwithav/sqlc/pkg/generator"
+	"github.com/stephenwithav/sqlc/pkg/metadata"
+	"github.com/stephenwithav/sqlc/pkg/migrations"
+	"github.com/stephenwithav/sqlc/pkg/opts"
+	"github.com/stephenwithav/sqlc/pkg/plugin"
+	"github.com/stephenwithav/sqlc/pkg/source"
+	"github.com/stephenwithav/sqlc/pkg/sql/ast"
+	"github.com/stephenwithav/sqlc/pkg/sql/catalog"
+)
+
+const diagnosticSource = "sqlc"
+
+// Schemas which are part of the engine, not the user's schema
+var builtinSchemas = map[string]struct{}{
+	"information_schema": {},
+	"pg_catalog":         {},
+	"pg_temp":            {},
+}
+
+// A document is an analyzed sqlc.yaml file.
+type document struct {
+	uri    string
+	path   string
+	text   string
+	blocks []*block
+	pkgs   []*pkg
+	diags  []Diagnostic
+}
+
+// A pkg is one compiled entry of the sql list.
+type pkg struct {
+	conf     config.SQL
+	compiler *compiler.Compiler
+	result   *compiler.Result
+	req      *plugin.CodeGenRequest
+	tables   map[string]*definition // by schema and name
+}
+
+// A definition records where a table was created.
+type definition struct {
+	block  *block
+	offset int
+}
+
+func analyze(uri, text string) *document {
+	doc := &document{uri: uri, path: uriPath(uri), text: text}
+	conf, err := config.ParseConfig(strings.NewReader(text))
+	if err != nil {
+		diag := configDiagnostic(text, err)
+		diag.Range = doc.toProtocol(diag.Range)
+		doc.diags = append(doc.diags, diag)
+		return doc
+	}
+	conf.Path = doc.path
+	doc.blocks = findBlocks(conf)
+	for i, sql := range conf.SQL {
+		doc.pkgs = append(doc.pkgs, doc.compile(i, conf, sql))
+	}
+	for i := range doc.diags {
+		doc.diags[i].Range = doc.toProtocol(doc.diags[i].Range)
+	}
+	return doc
+}
+
+// uriPath returns the file path of a file URI, or "" for any other URI.
+func uriPath(uri string) string {
+	u, err := url.Parse(uri)
+	if err != nil || u.Scheme != "file" {
+		return ""
+	}
+	path := u.Path
+	if len(path) > 2 && path[0] == '/' && path[2] == ':' {
+		// A Windows drive letter
+		path = path[1:]
+	}
+	return filepath.FromSlash(path)
+}
+
+var yamlLine = regexp.MustCompile(`line (\d+)`)
+
+func configDiagnostic(text string, err error) Diagnostic {
+	line := 0
+	if m := yamlLine.FindStringSubmatch(err.Error()); m != nil {
+		if n, err := strconv.Atoi(m[1]); err == nil && n > 0 {
+			line = n - 1
+		}
+	}
+	lines := strings.Split(text, "\n")
+	end := 0
+	if line < len(lines) {
+		end = len(lines[line])
+	}
+	return Diagnostic{
+		Range: Range{
+			Start: Position{Line: line},
+			End:   Position{Line: line, Character: end},
+		},
+		Severity: SeverityError,
+		Source:   diagnosticSource,
+		Message:  err.Error(),
+	}
+}
+
+func (doc *document) compile(idx int, conf config.Config, sql config.SQL) (p *pkg) {
+	p = &pkg{conf: sql, tables: map[string]*definition{}}
+	switch sql.Engine {
+	case config.EngineMySQL, config.EnginePostgreSQL, config.EngineSQLite:
+	default:
+		doc.addErrors(idx, kindSchema, fmt.Errorf("unknown engine: %s", sql.Engine))
+		return p
+	}
+
+	// The engines are not hardened against half-typed SQL. A panic must not
+	// take down the editor session.
+	defer func() {
+		if r := recover(); r != nil {
+			doc.addErrors(idx, kindQueries, fmt.Errorf("internal error: %v", r))
+		}
+	}()
+
+	combo := config.Combine(conf, sql)
+	c := compiler.NewCompiler(sql, combo)
+	p.compiler = c
+	if err := c.ParseCatalog(sql.Schema); err != nil {
+		doc.addErrors(idx, kindSchema, err)
+		return p
+	}
+	doc.indexTables(idx, p)
+	if err := c.ParseQueries(sql.Queries, opts.Parser{}); err != nil {
+		doc.addErrors(idx, kindQueries, err)
+		return p
+	}
+	p.result = c.Result()
+	p.req = generator.CodeGenRequest(p.result, combo)
+	return p
+}
+
+func (doc *document) blocksFor(idx int, kind string) []*block {
+	var out []*block
+	for _, b := range doc.blocks {
+		if b.pkg == idx && b.kind == kind {
+			out = append(out, b)
+		}
+	}
+	return out
+}
+
+func (doc *document) addErrors(idx int, kind string, err error) {
+	filename := (config.Config{Path: doc.path}).Filename()
+	for _, d := range diagnostic.FromError(err, filename, doc.text) {
+		var rng Range
+		if d.Filename == filename && d.Line > 0 {
+			rng = Range{
+				Start: Position{Line: d.Line - 1, Character: d.Column - 1},
+				End:   Position{Line: d.EndLine - 1, Character: d.EndColumn - 1},
+			}
+		} else {
+			// Report errors without a position at the start of the first
+			// block of the given kind
+			var pos Position
+			if blocks := doc.blocksFor(idx, kind); len(blocks) > 0 {
+				pos = blocks[0].toFile(1, 1)
+			}
+			rng = Range{Start: pos, End: wordEnd(doc.text, pos)}
+		}
+		doc.diags = append(doc.diags, Diagnostic{
+			Range:    rng,
+			Severity: SeverityError,
+			Code:     d.Rule,
+			Source:   diagnosticSource,
+			Message:  d.Message,
+		})
+	}
+}
+
+// wordEnd extends a position to the end of the token that starts there.
+func wordEnd(text string, pos Position) Position {
+	lines := strings.Split(text, "\n")
+	if pos.Line >= len(lines) {
+		return pos
+	}
+	line := lines[pos.Line]
+	end := pos.Character
+	for end < len(line) && line[end] != ' ' && line[end] != '\t' {
+		end++
+	}
+	return Position{Line: pos.Line, Character: end}
+}
+
+// line returns the text of a 0-based line of the document.
+func (doc *document) line(n int) string {
+	lines := strings.Split(doc.text, "\n")
+	if n < 0 || n >= len(lines) {
+		return ""
+	}
+	return lines[n]
+}
+
+// toProtocol converts a range whose characters are byte offsets into one
+// counted in UTF-16 code units.
+func (doc *document) toProtocol(rng Range) Range {
+	for _, pos := range []*Position{&rng.Start, &rng.End} {
+		line := doc.line(pos.Line)
+		if pos.Character > len(line) {
+			pos.Character = len(line)
+		}
+		units := 0
+		for _, r := range line[:pos.Character] {
+			units += len(utf16.Encode([]rune{r}))
+		}
+		pos.Character = units
+	}
+	return rng
+}
+
+// fromProtocol converts a position counted in UTF-16 code units into one
+// whose character is a byte offset.
+func (doc *document) fromProtocol(pos Position) Position {
+	line := doc.line(pos.Line)
+	units := 0
+	for i, r := range line {
+		if units >= pos.Character {
+			return Position{Line: pos.Line, Character: i}
+		}
+		units += len(utf16.Encode([]rune{r}))
+	}
+	return Position{Line: pos.Line, Character: len(line)}
+}
+
+func (doc *document) indexTables(idx int, p *pkg) {
+	parser := p.compiler.Parser()
+	for _, b := range doc.blocksFor(idx, kindSchema) {
+		contents := migrations.RemoveRollbackStatements(b.value)
+		stmts, err := parser.Parse(strings.NewReader(contents))
+		if err != nil {
+			continue
+		}
+		for _, stmt := range stmts {
+			if stmt.Raw == nil {
+				continue
+			}
+			create, ok := stmt.Raw.Stmt.(*ast.CreateTableStmt)
+			if !ok || create.Name == nil {
+				continue
+			}
+			line, col := source.LineNumber(contents, stmt.Raw.StmtLocation)
+			p.tables[p.tableKey(create.Name.Schema, create.Name.Name)] = &definition{
+				block:  b,
+				offset: lineColToOffset(b.value, line, col),
+			}
+		}
+	}
+}
+
+// tableKey returns the key of a table in p.tables. A table without a schema
+// belongs to the default schema of the catalog.
+func (p *pkg) tableKey(schema, name string) string {
+	if schema == "" {
+		schema = p.compiler.Catalog().DefaultSchema
+	}
+	return strings.ToLower(schema) + "." + strings.ToLower(name)
+}
+
+func lineColToOffset(text string, line, col int) int {
+	offset := 0
+	for i := 1; i < line; i++ {
+		next := strings.IndexByte(text[offset:], '\n')
+		if next < 0 {
+			return len(text)
+		}
+		offset += next + 1
+	}
+	offset += col - 1
+	if offset > len(text) {
+		offset = len(text)
+	}
+	return offset
+}
+
+// locate finds the SQL block containing a file position, as sent by the
+// client.
+func (doc *document) locate(pos Position) (*block, int, bool) {
+	pos = doc.fromProtocol(pos)
+	for _, b := range doc.blocks {
+		if offset, ok := b.fromFile(pos); ok {
+			return b, offset, true
+		}
+	}
+	return nil, 0, false
+}
+
+func (doc *document) pkgFor(b *block) *pkg {
+	if b.pkg < len(doc.pkgs) {
+		return doc.pkgs[b.pkg]
+	}
+	return nil
+}
+
+func isIdentChar(c byte) bool {
+	return c == '_' || c == '$' || ('a' <= c && c <= 'z') || ('A' <= c && c <= 'Z') || ('0' <= c && c <= '9')
+}
+
+// identAt returns the identifier surrounding offset, along with its
+// qualifier when written as qualifier.ident.
+func identAt(text string, offset int) (qualifier, ident string, start int) {
+	start = offset
+	for start > 0 && isIdentChar(text[start-1]) {
+		start--
+	}
+	end := offset
+	for end < len(text) && isIdentChar(text[end]) {
+		end++
+	}
+	ident = text[start:end]
+	if start > 0 && text[start-1] == '.' {
+		q := start - 1
+		for q > 0 && isIdentChar(text[q-1]) {
+			q--
+		}
+		qualifier = text[q : start-1]
+	}
+	return qualifier, ident, start
+}
+
+var commentSyntax = metadata.CommentSyntax{Dash: true, Hash: true, SlashStar: true}
+
+// queryAt returns the name of the query whose text contains offset.
+func queryAt(text string, offset int) string {
+	var name string
+	pos := 0
+	for _, line := range strings.SplitAfter(text, "\n") {
+		if pos > offset {
+			break
+		}
+		pos += len(line)
+		trimmed := strings.TrimSpace(line)
+		if !strings.Contains(trimmed, "name:") {
+			continue
+		}
+		if n, _, err := metadata.Parse(trimmed, commentSyntax); err == nil && n != "" {
+			name = n
+		}
+	}
+	return name
+}
+
+func (doc *document) hover(pos Position) *Hover {
+	b, offset, ok := doc.locate(pos)
+	if !ok || b.kind != kindQueries {
+		return nil
+	}
+	p := doc.pkgFor(b)
+	if p == nil || p.req == nil {
+		return nil
+	}
+	name := queryAt(b.value, offset)
+	if name == "" {
+		return nil
+	}
+	for _, q := range p.req.Queries {
+		if q.Name != name {
+			continue
+		}
+		var buf strings.Builder
+		fmt.Fprintf(&buf, "**%s** `%s`\n", q.Name, q.Cmd)
+		if len(q.Params) > 0 {
+			buf.WriteString("\nParameters:\n")
+			for _, param := range q.Params {
+				pname := param.Column.Name
+				if pname == "" {
+					pname = fmt.Sprintf("dollar_%d", param.Number)
+				}
+				// Only PostgreSQL numbers its placeholders
+				placeholder := "?"
+				if p.conf.Engine == config.EnginePostgreSQL {
+					placeholder = fmt.Sprintf("$%d", param.Number)
+				}
+				fmt.Fprintf(&buf, "- `%s` `%s %s`\n", placeholder, pname, golang.GoType(p.req, param.Column))
+			}
+		}
+		if len(q.Columns) > 0 {
+			buf.WriteString("\nColumns:\n")
+			for i, col := range q.Columns {
+				cname := col.Name
+				if cname == "" {
+					cname = fmt.Sprintf("column_%d", i+1)
+				}
+				fmt.Fprintf(&buf, "- `%s %s`\n", cname, golang.GoType(p.req, col))
+			}
+		}
+		return &Hover{Contents: MarkupContent{Kind: "markdown", Value: buf.String()}}
+	}
+	return nil
+}
+
+func userTables(c *catalog.Catalog) []*catalog.Table {
+	var tables []*catalog.Table
+	for _, s := range c.Schemas {
+		if _, ok := builtinSchemas[s.Name]; ok {
+			continue
+		}
+		tables = append(tables, s.Tables...)
+	}
+	return tables
+}
+
+func (doc *document) completion(pos Position) *CompletionList {
+	list := &CompletionList{Items: []CompletionItem{}}
+	b, offset, ok := doc.locate(pos)
+	if !ok {
+		return list
+	}
+	p := doc.pkgFor(b)
+	if p == nil || p.compiler == nil {
+		return list
+	}
+	cat := p.compiler.Catalog()
+	tables := userTables(cat)
+	qualifier, _, _ := identAt(b.value, offset)
+
+	if qualifier != "" {
+		for _, t := range tables {
+			if !strings.EqualFold(t.Rel.Name, qualifier) {
+				continue
+			}
+			for _, col := range t.Columns {
+				list.Items = append(list.Items, columnItem(t, col))
+			}
+		}
+		if len(list.Items) > 0 {
+			return list
+		}
+		// The qualifier may be a schema
+		for _, t := range tables {
+			if strings.EqualFold(t.Rel.Schema, qualifier) {
+				list.Items = append(list.Items, tableItem(t))
+			}
+		}
+		return list
+	}
+
+	for _, t := range tables {
+		list.Items = append(list.Items, tableItem(t))
+		for _, col := range t.Columns {
+			list.Items = append(list.Items, columnItem(t, col))
+		}
+	}
+	sort.SliceStable(list.Items, func(i, j int) bool {
+		return list.Items[i].Kind > list.Items[j].Kind
+	})
+	return list
+}
+
+func tableItem(t *catalog.Table) CompletionItem {
+	return CompletionItem{Label: t.Rel.Name, Kind: CompletionKindClass, Detail: "table"}
+}
+
+func columnItem(t *catalog.Table, col *catalog.Column) CompletionItem {
+	typ := col.Type.Name
+	if col.IsArray {
+		typ += "[]"
+	}
+	if col.IsNotNull {
+		typ += " not null"
+	}
+	return CompletionItem{
+		Label:  col.Name,
+		Kind:   CompletionKindField,
+		Detail: fmt.Sprintf("%s.%s %s", t.Rel.Name, col.Name, typ),
+	}
+}
+
+func (doc *document) definition(pos Position) []Location {
+	b, offset, ok := doc.locate(pos)
+	if !ok {
+		return nil
+	}
+	p := doc.pkgFor(b)
+	if p == nil || p.compiler == nil {
+		return nil
+	}
+	qualifier, ident, _ := identAt(b.value, offset)
+	def, ok := p.tables[p.tableKey(qualifier, ident)]
+	if !ok {
+		return nil
+	}
+	start := def.block.offsetToFile(def.offset)
+	return []Location{{
+		URI:   doc.uri,
+		Range: doc.toProtocol(Range{Start: start, End: wordEnd(doc.text, start)}),
+	}}
+}
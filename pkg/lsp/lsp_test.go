@@ -0,0 +1,333 @@
+package lsp
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+const uri = "file:///project/sqlc.yaml"
+
+const validConfig = `version: "2"
+sql:
+  - engine: "postgresql"
+    schema: |
+      CREATE TABLE authors (
+        id   BIGSERIAL PRIMARY KEY,
+        name text      NOT NULL,
+        bio  text
+      );
+    queries: |
+      -- name: GetAuthor :one
+      SELECT * FROM authors
+      WHERE id = $1 LIMIT 1;
+
+      -- name: ListAuthors :many
+      SELECT name, bio FROM authors
+      ORDER BY name;
+    gen:
+      go:
+        package: "db"
+        out: "db"
+`
+
+const brokenConfig = `version: "2"
+sql:
+  - engine: "postgresql"
+    schema: |
+      CREATE TABLE authors (
+        id   BIGSERIAL PRIMARY KEY,
+        name text      NOT NULL
+      );
+    queries: |
+      -- name: GetAuthor :one
+      SELECT foo FROM authors
+      WHERE id = $1 LIMIT 1;
+    gen:
+      go:
+        package: "db"
+        out: "db"
+`
+
+// session runs a server over the given client messages and returns every
+// message the server wrote.
+func session(t *testing.T, calls ...interface{}) []message {
+	t.Helper()
+	var in bytes.Buffer
+	for _, call := range calls {
+		body, err := json.Marshal(call)
+		if err != nil {
+			t.Fatal(err)
+		}
+		fmt.Fprintf(&in, "Content-Length: %d\r\n\r\n%s", len(body), body)
+	}
+	var out bytes.Buffer
+	if err := Serve(context.Background(), &in, &out); err != nil {
+		t.Fatal(err)
+	}
+	c := newConn(&out, nil)
+	var msgs []message
+	for {
+		msg, err := c.read()
+		if err != nil {
+			break
+		}
+		msgs = append(msgs, *msg)
+	}
+	return msgs
+}
+
+func request(id int, method string, params interface{}) map[string]interface{} {
+	return map[string]interface{}{"jsonrpc": "2.0", "id": id, "method": method, "params": params}
+}
+
+func notification(method string, params interface{}) map[string]interface{} {
+	return map[string]interface{}{"jsonrpc": "2.0", "method": method, "params": params}
+}
+
+func open(text string) map[string]interface{} {
+	return notification("textDocument/didOpen", DidOpenTextDocumentParams{
+		TextDocument: TextDocumentItem{URI: uri, LanguageID: "yaml", Version: 1, Text: text},
+	})
+}
+
+func at(line, char int) TextDocumentPositionParams {
+	return TextDocumentPositionParams{
+		TextDocument: TextDocumentIdentifier{URI: uri},
+		Position:     Position{Line: line, Character: char},
+	}
+}
+
+func result(t *testing.T, msgs []message, id int, v interface{}) {
+	t.Helper()
+	for _, msg := range msgs {
+		if msg.ID != nil && string(*msg.ID) == fmt.Sprint(id) {
+			if msg.Error != nil {
+				t.Fatalf("request %d failed: %s", id, msg.Error.Message)
+			}
+			if err := json.Unmarshal(msg.Result, v); err != nil {
+				t.Fatal(err)
+			}
+			return
+		}
+	}
+	t.Fatalf("no response for request %d", id)
+}
+
+func diagnostics(t *testing.T, msgs []message) []Diagnostic {
+	t.Helper()
+	for _, msg := range msgs {
+		if msg.Method == "textDocument/publishDiagnostics" {
+			var params PublishDiagnosticsParams
+			if err := json.Unmarshal(msg.Params, &params); err != nil {
+				t.Fatal(err)
+			}
+			return params.Diagnostics
+		}
+	}
+	t.Fatal("no diagnostics published")
+	return nil
+}
+
+func TestDiagnostics(t *testing.T) {
+	msgs := session(t,
+		request(1, "initialize", map[string]interface{}{}),
+		open(brokenConfig),
+		request(2, "shutdown", nil),
+		notification("exit", nil),
+	)
+	want := []Diagnostic{
+		{
+			Range: Range{
+				Start: Position{Line: 10, Character: 13},
+				End:   Position{Line: 10, Character: 16},
+			},
+			Severity: SeverityError,
+			Code:     "42703",
+			Source:   "sqlc",
+			Message:  `column "foo" does not exist`,
+		},
+	}
+	if diff := cmp.Diff(want, diagnostics(t, msgs)); diff != "" {
+		t.Errorf("diagnostics differ (-want +got):\n%s", diff)
+	}
+
+	msgs = session(t, open(validConfig), request(1, "shutdown", nil), notification("exit", nil))
+	if diags := diagnostics(t, msgs); len(diags) != 0 {
+		t.Errorf("expected no diagnostics, got %v", diags)
+	}
+}
+
+func TestHover(t *testing.T) {
+	msgs := session(t,
+		open(validConfig),
+		request(1, "textDocument/hover", at(12, 14)),
+		request(2, "textDocument/hover", at(15, 8)),
+		request(3, "textDocument/hover", at(4, 8)),
+		request(4, "shutdown", nil),
+		notification("exit", nil),
+	)
+	var hover Hover
+	result(t, msgs, 1, &hover)
+	want := "**GetAuthor** `:one`\n" +
+		"\nParameters:\n" +
+		"- `$1` `id int64`\n" +
+		"\nColumns:\n" +
+		"- `id int64`\n" +
+		"- `name string`\n" +
+		"- `bio sql.NullString`\n"
+	if diff := cmp.Diff(want, hover.Contents.Value); diff != "" {
+		t.Errorf("hover differs (-want +got):\n%s", diff)
+	}
+
+	result(t, msgs, 2, &hover)
+	if !strings.HasPrefix(hover.Contents.Value, "**ListAuthors** `:many`") {
+		t.Errorf("unexpected hover: %s", hover.Contents.Value)
+	}
+
+	var none *Hover
+	result(t, msgs, 3, &none)
+	if none != nil {
+		t.Errorf("expected no hover inside the schema, got %v", none)
+	}
+}
+
+func TestCompletion(t *testing.T) {
+	line := strings.Replace(validConfig, "WHERE id = $1", "WHERE authors. = $1", 1)
+	msgs := session(t,
+		open(line),
+		request(1, "textDocument/completion", at(12, 20)),
+		request(2, "textDocument/completion", at(11, 6)),
+		request(3, "shutdown", nil),
+		notification("exit", nil),
+	)
+	var list CompletionList
+	result(t, msgs, 1, &list)
+	var labels []string
+	for _, item := range list.Items {
+		labels = append(labels, item.Label)
+	}
+	if diff := cmp.Diff([]string{"id", "name", "bio"}, labels); diff != "" {
+		t.Errorf("column completion differs (-want +got):\n%s", diff)
+	}
+
+	result(t, msgs, 2, &list)
+	if len(list.Items) != 4 || list.Items[0].Label != "authors" || list.Items[0].Kind != CompletionKindClass {
+		t.Errorf("unexpected completion: %v", list.Items)
+	}
+}
+
+func TestDefinition(t *testing.T) {
+	msgs := session(t,
+		open(validConfig),
+		request(1, "textDocument/definition", at(11, 22)),
+		request(2, "shutdown", nil),
+		notification("exit", nil),
+	)
+	var locs []Location
+	result(t, msgs, 1, &locs)
+	want := []Location{
+		{
+			URI: uri,
+			Range: Range{
+				Start: Position{Line: 4, Character: 6},
+				End:   Position{Line: 4, Character: 12},
+			},
+		},
+	}
+	if diff := cmp.Diff(want, locs); diff != "" {
+		t.Errorf("definition differs (-want +got):\n%s", diff)
+	}
+}
+
+func TestUTF16Positions(t *testing.T) {
+	// é is one UTF-16 code unit but two bytes, the emoji two units but four
+	// bytes
+	text := strings.Replace(brokenConfig, "SELECT foo FROM", "SELECT 'é😀', foo FROM", 1)
+	msgs := session(t,
+		open(text),
+		request(1, "textDocument/definition", at(10, 29)),
+		request(2, "shutdown", nil),
+		notification("exit", nil),
+	)
+	want := Range{
+		Start: Position{Line: 10, Character: 20},
+		End:   Position{Line: 10, Character: 23},
+	}
+	if diags := diagnostics(t, msgs); len(diags) != 1 || diags[0].Range != want {
+		t.Errorf("expected a diagnostic at %v; got %v", want, diags)
+	}
+
+	var locs []Location
+	result(t, msgs, 1, &locs)
+	if len(locs) != 1 || locs[0].Range.Start != (Position{Line: 4, Character: 6}) {
+		t.Errorf("unexpected definition: %v", locs)
+	}
+}
+
+const schemasConfig = `version: "2"
+sql:
+  - engine: "postgresql"
+    schema: |
+      CREATE TABLE authors (id BIGSERIAL PRIMARY KEY);
+      CREATE SCHEMA archive;
+      CREATE TABLE archive.authors (id BIGSERIAL PRIMARY KEY);
+    queries: |
+      -- name: ListArchived :many
+      SELECT id FROM archive.authors;
+      -- name: ListAuthors :many
+      SELECT id FROM authors;
+    gen:
+      go:
+        package: "db"
+        out: "db"
+`
+
+func TestDefinitionSchemas(t *testing.T) {
+	msgs := session(t,
+		open(schemasConfig),
+		request(1, "textDocument/definition", at(9, 29)),
+		request(2, "textDocument/definition", at(11, 22)),
+		request(3, "shutdown", nil),
+		notification("exit", nil),
+	)
+	for id, line := range map[int]int{1: 6, 2: 4} {
+		var locs []Location
+		result(t, msgs, id, &locs)
+		if len(locs) != 1 || locs[0].Range.Start.Line != line {
+			t.Errorf("request %d: expected a definition on line %d; got %v", id, line, locs)
+		}
+	}
+}
+
+const sqliteConfig = `version: "2"
+sql:
+  - engine: "sqlite"
+    schema: "CREATE TABLE authors (id integer PRIMARY KEY, name text NOT NULL);"
+    queries: |
+      -- name: GetAuthor :one
+      SELECT name FROM authors WHERE id = ?;
+    gen:
+      go:
+        package: "db"
+        out: "db"
+`
+
+func TestHoverPlaceholders(t *testing.T) {
+	msgs := session(t,
+		open(sqliteConfig),
+		request(1, "textDocument/hover", at(6, 8)),
+		request(2, "shutdown", nil),
+		notification("exit", nil),
+	)
+	var hover Hover
+	result(t, msgs, 1, &hover)
+	if !strings.Contains(hover.Contents.Value, "- `?` `id int64`\n") {
+		t.Errorf("unexpected hover: %s", hover.Contents.Value)
+	}
+}
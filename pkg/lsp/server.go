@@ -0,0 +1,225 @@
+// Package lsp implements a Language Server Protocol server for the SQL
+// embedded in sqlc.yaml.
+//
+// The server speaks JSON-RPC over a pair of streams, usually stdin and
+// stdout. It reports compiler errors as diagnostics, shows the Go types of a
+// query's parameters and output columns on hover, completes table and column
+// names from the catalog and jumps from a table reference to its CREATE TABLE
+// statement.
+package lsp
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/stephenwithav/sqlc/pkg/info"
+)
+
+type Server struct {
+	conn *conn
+
+	mu   sync.Mutex
+	docs map[string]*document
+
+	shutdown bool
+}
+
+func NewServer(in io.Reader, out io.Writer) *Server {
+	return &Server{
+		conn: newConn(in, out),
+		docs: map[string]*document{},
+	}
+}
+
+// Serve runs a language server on the given streams until the client sends
+// an exit notification, the input is closed or the context is canceled.
+func Serve(ctx context.Context, in io.Reader, out io.Writer) error {
+	return NewServer(in, out).Run(ctx)
+}
+
+func (s *Server) Run(ctx context.Context) error {
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		msg, err := s.conn.read()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		var rerr *responseError
+		if errors.As(err, &rerr) {
+			if err := s.conn.reply(nil, nil, rerr); err != nil {
+				return err
+			}
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if msg.Method == "exit" {
+			if !s.shutdown {
+				return errors.New("exit before shutdown")
+			}
+			return nil
+		}
+		if err := s.handle(msg); err != nil {
+			return err
+		}
+	}
+}
+
+func (s *Server) handle(msg *message) error {
+	// Responses to requests sent by the server are ignored
+	if msg.Method == "" {
+		return nil
+	}
+	result, rerr := s.dispatch(msg)
+	if msg.ID == nil {
+		// Notifications never get a response
+		return nil
+	}
+	return s.conn.reply(msg.ID, result, rerr)
+}
+
+func decode(raw json.RawMessage, v interface{}) *responseError {
+	if err := json.Unmarshal(raw, v); err != nil {
+		return &responseError{Code: codeInvalidParams, Message: err.Error()}
+	}
+	return nil
+}
+
+func (s *Server) dispatch(msg *message) (interface{}, *responseError) {
+	if s.shutdown && msg.Method != "exit" {
+		return nil, &responseError{Code: codeInvalidRequest, Message: "server is shutting down"}
+	}
+	switch msg.Method {
+
+	case "initialize":
+		return &InitializeResult{
+			Capabilities: ServerCapabilities{
+				TextDocumentSync:   textDocumentSyncFull,
+				HoverProvider:      true,
+				DefinitionProvider: true,
+				CompletionProvider: &CompletionOptions{
+					TriggerCharacters: []string{"."},
+				},
+			},
+			ServerInfo: ServerInfo{Name: "sqlc", Version: info.Version},
+		}, nil
+
+	case "initialized":
+		return nil, nil
+
+	case "shutdown":
+		s.shutdown = true
+		return nil, nil
+
+	case "textDocument/didOpen":
+		var params DidOpenTextDocumentParams
+		if err := decode(msg.Params, &params); err != nil {
+			return nil, err
+		}
+		return nil, s.update(params.TextDocument.URI, params.TextDocument.Text)
+
+	case "textDocument/didChange":
+		var params DidChangeTextDocumentParams
+		if err := decode(msg.Params, &params); err != nil {
+			return nil, err
+		}
+		if len(params.ContentChanges) == 0 {
+			return nil, nil
+		}
+		// Only full document synchronization is advertised, so the last
+		// change holds the whole document
+		text := params.ContentChanges[len(params.ContentChanges)-1].Text
+		return nil, s.update(params.TextDocument.URI, text)
+
+	case "textDocument/didClose":
+		var params DidCloseTextDocumentParams
+		if err := decode(msg.Params, &params); err != nil {
+			return nil, err
+		}
+		s.mu.Lock()
+		delete(s.docs, params.TextDocument.URI)
+		s.mu.Unlock()
+		return nil, s.publish(params.TextDocument.URI, []Diagnostic{})
+
+	case "textDocument/hover":
+		var params TextDocumentPositionParams
+		if err := decode(msg.Params, &params); err != nil {
+			return nil, err
+		}
+		doc := s.document(params.TextDocument.URI)
+		if doc == nil {
+			return nil, nil
+		}
+		if h := doc.hover(params.Position); h != nil {
+			return h, nil
+		}
+		return nil, nil
+
+	case "textDocument/completion":
+		var params TextDocumentPositionParams
+		if err := decode(msg.Params, &params); err != nil {
+			return nil, err
+		}
+		doc := s.document(params.TextDocument.URI)
+		if doc == nil {
+			return &CompletionList{Items: []CompletionItem{}}, nil
+		}
+		return doc.completion(params.Position), nil
+
+	case "textDocument/definition":
+		var params TextDocumentPositionParams
+		if err := decode(msg.Params, &params); err != nil {
+			return nil, err
+		}
+		doc := s.document(params.TextDocument.URI)
+		if doc == nil {
+			return nil, nil
+		}
+		if locs := doc.definition(params.Position); len(locs) > 0 {
+			return locs, nil
+		}
+		return nil, nil
+
+	default:
+		return nil, &responseError{
+			Code:    codeMethodNotFound,
+			Message: fmt.Sprintf("method not found: %s", msg.Method),
+		}
+	}
+}
+
+func (s *Server) document(uri string) *document {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.docs[uri]
+}
+
+func (s *Server) update(uri, text string) *responseError {
+	doc := analyze(uri, text)
+	s.mu.Lock()
+	s.docs[uri] = doc
+	s.mu.Unlock()
+	diags := doc.diags
+	if diags == nil {
+		diags = []Diagnostic{}
+	}
+	return s.publish(uri, diags)
+}
+
+func (s *Server) publish(uri string, diags []Diagnostic) *responseError {
+	err := s.conn.notify("textDocument/publishDiagnostics", &PublishDiagnosticsParams{
+		URI:         uri,
+		Diagnostics: diags,
+	})
+	if err != nil {
+		return &responseError{Code: codeInternalError, Message: err.Error()}
+	}
+	return nil
+}
@@ -0,0 +1,189 @@
+package source
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/stephenwithav/sqlc/pkg/metadata"
+)
+
+// ParamFunc returns the text of the sqlc.arg or sqlc.narg call which starts
+// at loc in sql. Whitespace, line breaks and comments may appear between any
+// of the tokens of the call.
+func ParamFunc(sql string, loc int, cs metadata.CommentSyntax) (string, bool) {
+	_, end, ok := paramFunc(sql, loc, cs)
+	if !ok {
+		return "", false
+	}
+	return sql[loc:end], true
+}
+
+// ParamSign returns the text of the @name reference which starts at loc in
+// sql.
+func ParamSign(sql string, loc int, cs metadata.CommentSyntax) (string, bool) {
+	if loc < 0 || loc >= len(sql) || sql[loc] != '@' {
+		return "", false
+	}
+	i := skipSpace(sql, loc+1, cs)
+	end := identEnd(sql, i)
+	if end == i {
+		return "", false
+	}
+	return sql[loc:end], true
+}
+
+// ParamFuncs returns the location of every sqlc.arg and sqlc.narg call in
+// sql together with the end of its function name. Calls inside strings,
+// quoted identifiers and comments are ignored.
+func ParamFuncs(sql string, cs metadata.CommentSyntax) [][2]int {
+	var calls [][2]int
+	for i := 0; i < len(sql); {
+		if j := skipSpace(sql, i, cs); j > i {
+			i = j
+			continue
+		}
+		switch c := sql[i]; {
+		case c == '\'' || c == '"' || c == '`':
+			i = quotedEnd(sql, i)
+		case isIdentByte(c):
+			end := identEnd(sql, i)
+			prev := skipSpaceBack(sql, i)
+			if prev == 0 || sql[prev-1] != '.' {
+				if name, _, ok := paramFunc(sql, i, cs); ok {
+					calls = append(calls, [2]int{i, name})
+				}
+			}
+			i = end
+		default:
+			i++
+		}
+	}
+	return calls
+}
+
+// paramFunc returns the end of the function name and the end of the
+// sqlc.arg or sqlc.narg call starting at loc.
+func paramFunc(sql string, loc int, cs metadata.CommentSyntax) (int, int, bool) {
+	if loc < 0 || loc >= len(sql) {
+		return 0, 0, false
+	}
+	end := identEnd(sql, loc)
+	if unquote(sql[loc:end]) != "sqlc" {
+		return 0, 0, false
+	}
+	i := skipSpace(sql, end, cs)
+	if i >= len(sql) || sql[i] != '.' {
+		return 0, 0, false
+	}
+	i = skipSpace(sql, i+1, cs)
+	name := identEnd(sql, i)
+	if fn := unquote(sql[i:name]); fn != "arg" && fn != "narg" {
+		return 0, 0, false
+	}
+	i = skipSpace(sql, name, cs)
+	if i >= len(sql) || sql[i] != '(' {
+		return 0, 0, false
+	}
+	depth := 0
+	for i < len(sql) {
+		if j := skipSpace(sql, i, cs); j > i {
+			i = j
+			continue
+		}
+		switch sql[i] {
+		case '\'', '"', '`':
+			i = quotedEnd(sql, i)
+			continue
+		case '(':
+			depth++
+		case ')':
+			depth--
+			if depth == 0 {
+				return name, i + 1, true
+			}
+		}
+		i++
+	}
+	return 0, 0, false
+}
+
+// skipSpace returns the first offset at or after i which is not whitespace
+// or part of a comment.
+func skipSpace(sql string, i int, cs metadata.CommentSyntax) int {
+	for i < len(sql) {
+		switch {
+		case sql[i] == ' ' || sql[i] == '\t' || sql[i] == '\n' || sql[i] == '\r' || sql[i] == '\f':
+			i++
+		case cs.Dash && strings.HasPrefix(sql[i:], "--"), cs.Hash && sql[i] == '#':
+			if n := strings.IndexByte(sql[i:], '\n'); n >= 0 {
+				i += n + 1
+			} else {
+				i = len(sql)
+			}
+		case cs.SlashStar && strings.HasPrefix(sql[i:], "/*"):
+			if n := strings.Index(sql[i+2:], "*/"); n >= 0 {
+				i += n + 4
+			} else {
+				i = len(sql)
+			}
+		default:
+			return i
+		}
+	}
+	return i
+}
+
+// skipSpaceBack returns the offset just past the last character before i
+// which is not whitespace.
+func skipSpaceBack(sql string, i int) int {
+	for i > 0 && strings.IndexByte(" \t\n\r\f", sql[i-1]) >= 0 {
+		i--
+	}
+	return i
+}
+
+// identEnd returns the end of the identifier, bare or quoted, at i.
+func identEnd(sql string, i int) int {
+	if i < len(sql) && (sql[i] == '"' || sql[i] == '`') {
+		return quotedEnd(sql, i)
+	}
+	for i < len(sql) {
+		r, size := utf8.DecodeRuneInString(sql[i:])
+		if !(r == '_' || r == '$' || unicode.IsLetter(r) || unicode.IsDigit(r)) {
+			break
+		}
+		i += size
+	}
+	return i
+}
+
+// quotedEnd returns the offset just past the string or quoted identifier
+// which starts at i. A doubled quote character does not end it.
+func quotedEnd(sql string, i int) int {
+	q := sql[i]
+	for i++; i < len(sql); i++ {
+		if sql[i] != q {
+			continue
+		}
+		if i+1 < len(sql) && sql[i+1] == q {
+			i++
+			continue
+		}
+		return i + 1
+	}
+	return len(sql)
+}
+
+func isIdentByte(c byte) bool {
+	return c == '_' || c >= 0x80 || 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z'
+}
+
+// unquote returns the name of an identifier. Bare identifiers are folded to
+// lower case.
+func unquote(ident string) string {
+	if len(ident) >= 2 && (ident[0] == '"' || ident[0] == '`') {
+		return ident[1 : len(ident)-1]
+	}
+	return strings.ToLower(ident)
+}
@@ -8,3 +8,9 @@ type A_ArrayExpr struct {
 func (n *A_ArrayExpr) Pos() int {
 	return n.Location
 }
+
+func (n *A_ArrayExpr) Format(buf *TrackedBuffer) {
+	buf.WriteString("ARRAY[")
+	buf.join(n.Elements, ", ")
+	buf.WriteString("]")
+}
@@ -8,3 +8,12 @@ type A_Const struct {
 func (n *A_Const) Pos() int {
 	return n.Location
 }
+
+func (n *A_Const) Format(buf *TrackedBuffer) {
+	switch v := n.Val.(type) {
+	case *String:
+		buf.quoted(v.Str)
+	default:
+		buf.astFormat(v)
+	}
+}
@@ -11,3 +11,113 @@ type A_Expr struct {
 func (n *A_Expr) Pos() int {
 	return n.Location
 }
+
+func (n *A_Expr) Format(buf *TrackedBuffer) {
+	op := opName(n.Name)
+	switch n.Kind {
+	case A_Expr_Kind_OP, 0:
+		switch {
+		case op == "@" && !present(n.Lexpr):
+			// sqlc's named parameters
+			if _, ok := n.Rexpr.(*ColumnRef); ok {
+				buf.WriteString("@")
+				buf.astFormat(n.Rexpr)
+				return
+			}
+			buf.WriteString("@ ")
+			buf.paren(n.Rexpr)
+		case !present(n.Lexpr):
+			buf.WriteString(op)
+			buf.WriteString(" ")
+			buf.paren(n.Rexpr)
+		case !present(n.Rexpr):
+			buf.paren(n.Lexpr)
+			buf.WriteString(" ")
+			buf.WriteString(op)
+		default:
+			buf.paren(n.Lexpr)
+			buf.WriteString(" ")
+			buf.WriteString(op)
+			buf.WriteString(" ")
+			buf.paren(n.Rexpr)
+		}
+
+	case A_Expr_Kind_OP_ANY, A_Expr_Kind_OP_ALL:
+		buf.paren(n.Lexpr)
+		buf.WriteString(" ")
+		buf.WriteString(op)
+		if n.Kind == A_Expr_Kind_OP_ANY {
+			buf.WriteString(" ANY (")
+		} else {
+			buf.WriteString(" ALL (")
+		}
+		buf.astFormat(n.Rexpr)
+		buf.WriteString(")")
+
+	case A_Expr_Kind_DISTINCT, A_Expr_Kind_NOT_DISTINCT:
+		buf.paren(n.Lexpr)
+		if n.Kind == A_Expr_Kind_DISTINCT {
+			buf.WriteString(" IS DISTINCT FROM ")
+		} else {
+			buf.WriteString(" IS NOT DISTINCT FROM ")
+		}
+		buf.paren(n.Rexpr)
+
+	case A_Expr_Kind_NULLIF:
+		buf.WriteString("NULLIF(")
+		buf.astFormat(n.Lexpr)
+		buf.WriteString(", ")
+		buf.astFormat(n.Rexpr)
+		buf.WriteString(")")
+
+	case A_Expr_Kind_IN:
+		buf.paren(n.Lexpr)
+		if op == "<>" {
+			buf.WriteString(" NOT IN (")
+		} else {
+			buf.WriteString(" IN (")
+		}
+		buf.astFormat(n.Rexpr)
+		buf.WriteString(")")
+
+	case A_Expr_Kind_LIKE, A_Expr_Kind_ILIKE:
+		buf.paren(n.Lexpr)
+		switch op {
+		case "~~":
+			buf.WriteString(" LIKE ")
+		case "!~~":
+			buf.WriteString(" NOT LIKE ")
+		case "~~*":
+			buf.WriteString(" ILIKE ")
+		case "!~~*":
+			buf.WriteString(" NOT ILIKE ")
+		default:
+			buf.unsupported(n)
+		}
+		buf.paren(n.Rexpr)
+
+	case A_Expr_Kind_BETWEEN, A_Expr_Kind_NOT_BETWEEN, A_Expr_Kind_BETWEEN_SYM, A_Expr_Kind_NOT_BETWEEN_SYM:
+		bounds, ok := n.Rexpr.(*List)
+		if !ok || len(bounds.Items) != 2 {
+			buf.unsupported(n)
+			return
+		}
+		buf.paren(n.Lexpr)
+		switch n.Kind {
+		case A_Expr_Kind_BETWEEN:
+			buf.WriteString(" BETWEEN ")
+		case A_Expr_Kind_NOT_BETWEEN:
+			buf.WriteString(" NOT BETWEEN ")
+		case A_Expr_Kind_BETWEEN_SYM:
+			buf.WriteString(" BETWEEN SYMMETRIC ")
+		case A_Expr_Kind_NOT_BETWEEN_SYM:
+			buf.WriteString(" NOT BETWEEN SYMMETRIC ")
+		}
+		buf.paren(bounds.Items[0])
+		buf.WriteString(" AND ")
+		buf.paren(bounds.Items[1])
+
+	default:
+		buf.unsupported(n)
+	}
+}
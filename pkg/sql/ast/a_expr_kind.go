@@ -1,5 +1,26 @@
 package ast
 
+// Enum copies the values of the pg_query protobuf
+const (
+	_ A_Expr_Kind = iota
+	A_Expr_Kind_OP
+	A_Expr_Kind_OP_ANY
+	A_Expr_Kind_OP_ALL
+	A_Expr_Kind_DISTINCT
+	A_Expr_Kind_NOT_DISTINCT
+	A_Expr_Kind_NULLIF
+	A_Expr_Kind_OF
+	A_Expr_Kind_IN
+	A_Expr_Kind_LIKE
+	A_Expr_Kind_ILIKE
+	A_Expr_Kind_SIMILAR
+	A_Expr_Kind_BETWEEN
+	A_Expr_Kind_NOT_BETWEEN
+	A_Expr_Kind_BETWEEN_SYM
+	A_Expr_Kind_NOT_BETWEEN_SYM
+	A_Expr_Kind_PAREN
+)
+
 type A_Expr_Kind uint
 
 func (n *A_Expr_Kind) Pos() int {
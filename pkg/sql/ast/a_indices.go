@@ -9,3 +9,17 @@ type A_Indices struct {
 func (n *A_Indices) Pos() int {
 	return 0
 }
+
+func (n *A_Indices) Format(buf *TrackedBuffer) {
+	buf.WriteString("[")
+	if present(n.Lidx) {
+		buf.astFormat(n.Lidx)
+	}
+	if n.IsSlice {
+		buf.WriteString(":")
+	}
+	if present(n.Uidx) {
+		buf.astFormat(n.Uidx)
+	}
+	buf.WriteString("]")
+}
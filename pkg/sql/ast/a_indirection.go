@@ -8,3 +8,23 @@ type A_Indirection struct {
 func (n *A_Indirection) Pos() int {
 	return 0
 }
+
+func (n *A_Indirection) Format(buf *TrackedBuffer) {
+	switch n.Arg.(type) {
+	case *ColumnRef, *ParamRef:
+		buf.astFormat(n.Arg)
+	default:
+		buf.WriteString("(")
+		buf.astFormat(n.Arg)
+		buf.WriteString(")")
+	}
+	if n.Indirection == nil {
+		return
+	}
+	for _, item := range n.Indirection.Items {
+		if _, ok := item.(*A_Indices); !ok {
+			buf.WriteString(".")
+		}
+		buf.astFormat(item)
+	}
+}
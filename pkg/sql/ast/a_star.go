@@ -6,3 +6,7 @@ type A_Star struct {
 func (n *A_Star) Pos() int {
 	return 0
 }
+
+func (n *A_Star) Format(buf *TrackedBuffer) {
+	buf.WriteString("*")
+}
@@ -8,3 +8,14 @@ type Alias struct {
 func (n *Alias) Pos() int {
 	return 0
 }
+
+func (n *Alias) Format(buf *TrackedBuffer) {
+	if n.Aliasname != nil {
+		buf.ident(*n.Aliasname)
+	}
+	if items(n.Colnames) > 0 {
+		buf.WriteString("(")
+		buf.join(n.Colnames, ", ")
+		buf.WriteString(")")
+	}
+}
@@ -7,6 +7,9 @@ type AlterDomainStmt struct {
 	Def       Node
 	Behavior  DropBehavior
 	MissingOk bool
+
+	// Domain is filled in by the engine
+	Domain *TypeName
 }
 
 func (n *AlterDomainStmt) Pos() int {
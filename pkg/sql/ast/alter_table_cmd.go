@@ -39,3 +39,42 @@ type AlterTableCmd struct {
 func (n *AlterTableCmd) Pos() int {
 	return 0
 }
+
+func (n *AlterTableCmd) Format(buf *TrackedBuffer) {
+	name := func() {
+		if n.Name != nil {
+			buf.ident(*n.Name)
+		}
+	}
+	switch n.Subtype {
+	case AT_AddColumn:
+		buf.WriteString("ADD COLUMN ")
+		if n.MissingOk {
+			buf.WriteString("IF NOT EXISTS ")
+		}
+		buf.astFormat(n.Def)
+	case AT_DropColumn:
+		buf.WriteString("DROP COLUMN ")
+		if n.MissingOk {
+			buf.WriteString("IF EXISTS ")
+		}
+		name()
+	case AT_AlterColumnType:
+		buf.WriteString("ALTER COLUMN ")
+		name()
+		buf.WriteString(" TYPE ")
+		if n.Def != nil {
+			buf.astFormat(n.Def.TypeName)
+		}
+	case AT_DropNotNull:
+		buf.WriteString("ALTER COLUMN ")
+		name()
+		buf.WriteString(" DROP NOT NULL")
+	case AT_SetNotNull:
+		buf.WriteString("ALTER COLUMN ")
+		name()
+		buf.WriteString(" SET NOT NULL")
+	default:
+		buf.unsupported(n)
+	}
+}
@@ -12,3 +12,17 @@ type AlterTableStmt struct {
 func (n *AlterTableStmt) Pos() int {
 	return 0
 }
+
+func (n *AlterTableStmt) Format(buf *TrackedBuffer) {
+	buf.WriteString("ALTER TABLE ")
+	if n.MissingOk {
+		buf.WriteString("IF EXISTS ")
+	}
+	if n.Table != nil {
+		buf.astFormat(n.Table)
+	} else {
+		buf.astFormat(n.Relation)
+	}
+	buf.WriteString(" ")
+	buf.join(n.Cmds, ", ")
+}
@@ -15,3 +15,15 @@ type BetweenExpr struct {
 func (n *BetweenExpr) Pos() int {
 	return n.Location
 }
+
+func (n *BetweenExpr) Format(buf *TrackedBuffer) {
+	buf.paren(n.Expr)
+	if n.Not {
+		buf.WriteString(" NOT BETWEEN ")
+	} else {
+		buf.WriteString(" BETWEEN ")
+	}
+	buf.paren(n.Left)
+	buf.WriteString(" AND ")
+	buf.paren(n.Right)
+}
@@ -10,3 +10,34 @@ type BoolExpr struct {
 func (n *BoolExpr) Pos() int {
 	return n.Location
 }
+
+func (n *BoolExpr) Format(buf *TrackedBuffer) {
+	switch n.Boolop {
+	case BoolExprTypeAnd, BoolExprTypeOr:
+		sep := " AND "
+		if n.Boolop == BoolExprTypeOr {
+			sep = " OR "
+		}
+		for i, arg := range n.Args.Items {
+			if i > 0 {
+				buf.WriteString(sep)
+			}
+			if b, ok := arg.(*BoolExpr); ok && b.Boolop != n.Boolop && b.Boolop != BoolExprTypeNot {
+				buf.WriteString("(")
+				buf.astFormat(arg)
+				buf.WriteString(")")
+				continue
+			}
+			buf.astFormat(arg)
+		}
+	case BoolExprTypeNot:
+		if items(n.Args) != 1 {
+			buf.unsupported(n)
+			return
+		}
+		buf.WriteString("NOT ")
+		buf.paren(n.Args.Items[0])
+	default:
+		buf.unsupported(n)
+	}
+}
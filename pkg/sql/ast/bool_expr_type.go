@@ -1,5 +1,13 @@
 package ast
 
+// Enum copies the values of the pg_query protobuf
+const (
+	_ BoolExprType = iota
+	BoolExprTypeAnd
+	BoolExprTypeOr
+	BoolExprTypeNot
+)
+
 type BoolExprType uint
 
 func (n *BoolExprType) Pos() int {
@@ -1,5 +1,16 @@
 package ast
 
+// Enum copies the values of the pg_query protobuf
+const (
+	_ BoolTestType = iota
+	BoolTestTypeIsTrue
+	BoolTestTypeIsNotTrue
+	BoolTestTypeIsFalse
+	BoolTestTypeIsNotFalse
+	BoolTestTypeIsUnknown
+	BoolTestTypeIsNotUnknown
+)
+
 type BoolTestType uint
 
 func (n *BoolTestType) Pos() int {
@@ -10,3 +10,23 @@ type BooleanTest struct {
 func (n *BooleanTest) Pos() int {
 	return n.Location
 }
+
+func (n *BooleanTest) Format(buf *TrackedBuffer) {
+	buf.paren(n.Arg)
+	switch n.Booltesttype {
+	case BoolTestTypeIsTrue:
+		buf.WriteString(" IS TRUE")
+	case BoolTestTypeIsNotTrue:
+		buf.WriteString(" IS NOT TRUE")
+	case BoolTestTypeIsFalse:
+		buf.WriteString(" IS FALSE")
+	case BoolTestTypeIsNotFalse:
+		buf.WriteString(" IS NOT FALSE")
+	case BoolTestTypeIsUnknown:
+		buf.WriteString(" IS UNKNOWN")
+	case BoolTestTypeIsNotUnknown:
+		buf.WriteString(" IS NOT UNKNOWN")
+	default:
+		buf.unsupported(n)
+	}
+}
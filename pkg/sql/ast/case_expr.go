@@ -13,3 +13,22 @@ type CaseExpr struct {
 func (n *CaseExpr) Pos() int {
 	return n.Location
 }
+
+func (n *CaseExpr) Format(buf *TrackedBuffer) {
+	buf.WriteString("CASE")
+	if present(n.Arg) {
+		buf.WriteString(" ")
+		buf.astFormat(n.Arg)
+	}
+	if n.Args != nil {
+		for _, when := range n.Args.Items {
+			buf.WriteString(" ")
+			buf.astFormat(when)
+		}
+	}
+	if present(n.Defresult) {
+		buf.WriteString(" ELSE ")
+		buf.astFormat(n.Defresult)
+	}
+	buf.WriteString(" END")
+}
@@ -10,3 +10,10 @@ type CaseWhen struct {
 func (n *CaseWhen) Pos() int {
 	return n.Location
 }
+
+func (n *CaseWhen) Format(buf *TrackedBuffer) {
+	buf.WriteString("WHEN ")
+	buf.astFormat(n.Expr)
+	buf.WriteString(" THEN ")
+	buf.astFormat(n.Result)
+}
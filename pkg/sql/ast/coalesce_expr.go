@@ -11,3 +11,9 @@ type CoalesceExpr struct {
 func (n *CoalesceExpr) Pos() int {
 	return n.Location
 }
+
+func (n *CoalesceExpr) Format(buf *TrackedBuffer) {
+	buf.WriteString("COALESCE(")
+	buf.join(n.Args, ", ")
+	buf.WriteString(")")
+}
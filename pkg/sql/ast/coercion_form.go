@@ -1,5 +1,13 @@
 package ast
 
+// Enum copies the values of the pg_query protobuf
+const (
+	_ CoercionForm = iota
+	CoercionFormExplicitCall
+	CoercionFormExplicitCast
+	CoercionFormImplicitCast
+)
+
 type CoercionForm uint
 
 func (n *CoercionForm) Pos() int {
@@ -9,3 +9,9 @@ type CollateClause struct {
 func (n *CollateClause) Pos() int {
 	return n.Location
 }
+
+func (n *CollateClause) Format(buf *TrackedBuffer) {
+	buf.paren(n.Arg)
+	buf.WriteString(" COLLATE ")
+	buf.join(n.Collname, ".")
+}
@@ -17,14 +17,30 @@ type ColumnDef struct {
 	RawDefault    Node
 	CookedDefault Node
 	Identity      byte
+	Generated     byte
 	CollClause    *CollateClause
 	CollOid       Oid
 	Constraints   *List
 	Fdwoptions    *List
 	Location      int
 	Comment       string
+	// IsHidden is set for the hidden columns of a virtual table, which are
+	// not selected by *
+	IsHidden bool
 }
 
 func (n *ColumnDef) Pos() int {
 	return n.Location
 }
+
+func (n *ColumnDef) Format(buf *TrackedBuffer) {
+	buf.ident(n.Colname)
+	buf.WriteString(" ")
+	buf.astFormat(n.TypeName)
+	if n.IsArray && n.TypeName != nil && n.TypeName.ArrayBounds == nil {
+		buf.WriteString("[]")
+	}
+	if n.IsNotNull {
+		buf.WriteString(" NOT NULL")
+	}
+}
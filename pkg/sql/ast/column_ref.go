@@ -11,3 +11,11 @@ type ColumnRef struct {
 func (n *ColumnRef) Pos() int {
 	return n.Location
 }
+
+func (n *ColumnRef) Format(buf *TrackedBuffer) {
+	if items(n.Fields) == 0 {
+		buf.ident(n.Name)
+		return
+	}
+	buf.join(n.Fields, ".")
+}
@@ -16,3 +16,17 @@ type CommonTableExpr struct {
 func (n *CommonTableExpr) Pos() int {
 	return n.Location
 }
+
+func (n *CommonTableExpr) Format(buf *TrackedBuffer) {
+	if n.Ctename != nil {
+		buf.ident(*n.Ctename)
+	}
+	if items(n.Aliascolnames) > 0 {
+		buf.WriteString("(")
+		buf.join(n.Aliascolnames, ", ")
+		buf.WriteString(")")
+	}
+	buf.WriteString(" AS (")
+	buf.astFormat(n.Ctequery)
+	buf.WriteString(")")
+}
@@ -5,6 +5,13 @@ type CreateDomainStmt struct {
 	TypeName    *TypeName
 	CollClause  *CollateClause
 	Constraints *List
+
+	// Name, IsArray, IsNotNull and Default are filled in by the engine,
+	// which then only leaves the CHECK constraints in Constraints
+	Name      *TypeName
+	IsArray   bool
+	IsNotNull bool
+	Default   Node
 }
 
 func (n *CreateDomainStmt) Pos() int {
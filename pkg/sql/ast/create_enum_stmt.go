@@ -8,3 +8,18 @@ type CreateEnumStmt struct {
 func (n *CreateEnumStmt) Pos() int {
 	return 0
 }
+
+func (n *CreateEnumStmt) Format(buf *TrackedBuffer) {
+	buf.WriteString("CREATE TYPE ")
+	buf.astFormat(n.TypeName)
+	buf.WriteString(" AS ENUM (")
+	if n.Vals != nil {
+		for i, val := range n.Vals.Items {
+			if i > 0 {
+				buf.WriteString(", ")
+			}
+			buf.quoted(stringValue(val))
+		}
+	}
+	buf.WriteString(")")
+}
@@ -7,8 +7,36 @@ type CreateTableStmt struct {
 	ReferTable  *TableName
 	Comment     string
 	Inherits    []*TableName
+	Indexes     []*IndexStmt
+	Partition   *PartitionSpec
+	// Strict is set for the STRICT tables of SQLite
+	Strict bool
 }
 
 func (n *CreateTableStmt) Pos() int {
 	return 0
 }
+
+func (n *CreateTableStmt) Format(buf *TrackedBuffer) {
+	buf.WriteString("CREATE TABLE ")
+	if n.IfNotExists {
+		buf.WriteString("IF NOT EXISTS ")
+	}
+	buf.astFormat(n.Name)
+	if n.ReferTable != nil {
+		buf.WriteString(" LIKE ")
+		buf.astFormat(n.ReferTable)
+		return
+	}
+	buf.WriteString(" (")
+	for i, col := range n.Cols {
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+		buf.astFormat(col)
+	}
+	buf.WriteString(")")
+	if n.Strict {
+		buf.WriteString(" STRICT")
+	}
+}
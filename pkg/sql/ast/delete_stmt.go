@@ -11,3 +11,17 @@ type DeleteStmt struct {
 func (n *DeleteStmt) Pos() int {
 	return 0
 }
+
+func (n *DeleteStmt) Format(buf *TrackedBuffer) {
+	if n.WithClause != nil {
+		buf.astFormat(n.WithClause)
+	}
+	buf.WriteString("DELETE FROM ")
+	buf.astFormat(n.Relation)
+	buf.clause("USING", n.UsingClause)
+	if present(n.WhereClause) {
+		buf.WriteString(" WHERE ")
+		buf.astFormat(n.WhereClause)
+	}
+	buf.clause("RETURNING", n.ReturningList)
+}
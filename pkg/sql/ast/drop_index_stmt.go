@@ -0,0 +1,30 @@
+package ast
+
+type DropIndexStmt struct {
+	IfExists bool
+	Indexes  []*TableName
+	// Table is set when the statement names the table of the indexes, as
+	// MySQL requires
+	Table *TableName
+}
+
+func (n *DropIndexStmt) Pos() int {
+	return 0
+}
+
+func (n *DropIndexStmt) Format(buf *TrackedBuffer) {
+	buf.WriteString("DROP INDEX ")
+	if n.IfExists {
+		buf.WriteString("IF EXISTS ")
+	}
+	for i, index := range n.Indexes {
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+		buf.astFormat(index)
+	}
+	if n.Table != nil {
+		buf.WriteString(" ON ")
+		buf.astFormat(n.Table)
+	}
+}
@@ -0,0 +1,23 @@
+package ast
+
+type DropSequenceStmt struct {
+	IfExists  bool
+	Sequences []*TableName
+}
+
+func (n *DropSequenceStmt) Pos() int {
+	return 0
+}
+
+func (n *DropSequenceStmt) Format(buf *TrackedBuffer) {
+	buf.WriteString("DROP SEQUENCE ")
+	if n.IfExists {
+		buf.WriteString("IF EXISTS ")
+	}
+	for i, seq := range n.Sequences {
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+		buf.astFormat(seq)
+	}
+}
@@ -8,3 +8,16 @@ type DropTableStmt struct {
 func (n *DropTableStmt) Pos() int {
 	return 0
 }
+
+func (n *DropTableStmt) Format(buf *TrackedBuffer) {
+	buf.WriteString("DROP TABLE ")
+	if n.IfExists {
+		buf.WriteString("IF EXISTS ")
+	}
+	for i, table := range n.Tables {
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+		buf.astFormat(table)
+	}
+}
@@ -7,3 +7,7 @@ type Float struct {
 func (n *Float) Pos() int {
 	return 0
 }
+
+func (n *Float) Format(buf *TrackedBuffer) {
+	buf.WriteString(n.Str)
+}
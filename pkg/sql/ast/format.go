@@ -0,0 +1,184 @@
+package ast
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Dialect describes how an engine spells the parts of a statement which
+// differ between SQL dialects.
+type Dialect interface {
+	// QuoteIdent returns an identifier, quoted if the dialect requires it
+	QuoteIdent(string) string
+
+	// TypeName returns the name of a type in the given namespace
+	TypeName(ns, name string) string
+
+	// Param returns the placeholder for the numbered parameter
+	Param(int) string
+
+	// Cast returns an expression converting arg to the type
+	Cast(arg, typeName string) string
+
+	// OnDuplicateKey reports whether an upsert is written ON DUPLICATE KEY
+	// UPDATE instead of ON CONFLICT
+	OnDuplicateKey() bool
+
+	// FuncCall returns a call the dialect writes with keywords between its
+	// formatted arguments, as in TRIM(LEADING 'x' FROM title), and false
+	// for an ordinary call
+	FuncCall(n *FuncCall, args []string) (string, bool)
+}
+
+// A TrackedBuffer collects the SQL text of a node tree. The first node which
+// cannot be printed is recorded as an error.
+type TrackedBuffer struct {
+	strings.Builder
+
+	dialect Dialect
+	err     error
+}
+
+type formatter interface {
+	Format(buf *TrackedBuffer)
+}
+
+// Format renders a node tree as SQL in the given dialect.
+func Format(n Node, d Dialect) (string, error) {
+	buf := &TrackedBuffer{dialect: d}
+	buf.astFormat(n)
+	if buf.err != nil {
+		return "", buf.err
+	}
+	return buf.String(), nil
+}
+
+func (buf *TrackedBuffer) astFormat(n Node) {
+	if isNil(n) {
+		return
+	}
+	f, ok := n.(formatter)
+	if !ok {
+		buf.unsupported(n)
+		return
+	}
+	f.Format(buf)
+}
+
+func (buf *TrackedBuffer) unsupported(n Node) {
+	if buf.err == nil {
+		buf.err = fmt.Errorf("cannot format %T", n)
+	}
+}
+
+// join formats the items of a list separated by sep.
+func (buf *TrackedBuffer) join(list *List, sep string) {
+	if list == nil {
+		return
+	}
+	for i, item := range list.Items {
+		if i > 0 {
+			buf.WriteString(sep)
+		}
+		buf.astFormat(item)
+	}
+}
+
+// clause writes a keyword followed by the items of a list, if there are any.
+func (buf *TrackedBuffer) clause(keyword string, list *List) {
+	if items(list) == 0 {
+		return
+	}
+	buf.WriteString(" ")
+	buf.WriteString(keyword)
+	buf.WriteString(" ")
+	buf.join(list, ", ")
+}
+
+// paren formats an operand, in parentheses when it is itself an operator
+// expression.
+func (buf *TrackedBuffer) paren(n Node) {
+	switch n.(type) {
+	case *A_Expr, *BoolExpr, *BetweenExpr, *In, *NullTest, *BooleanTest:
+		buf.WriteString("(")
+		buf.astFormat(n)
+		buf.WriteString(")")
+	default:
+		buf.astFormat(n)
+	}
+}
+
+func (buf *TrackedBuffer) ident(name string) {
+	buf.WriteString(buf.dialect.QuoteIdent(name))
+}
+
+// quoted writes a string literal.
+func (buf *TrackedBuffer) quoted(s string) {
+	buf.WriteString("'")
+	buf.WriteString(strings.ReplaceAll(s, "'", "''"))
+	buf.WriteString("'")
+}
+
+func items(list *List) int {
+	if list == nil {
+		return 0
+	}
+	return len(list.Items)
+}
+
+// isNil reports whether n is nil or a typed nil pointer.
+func isNil(n Node) bool {
+	if n == nil {
+		return true
+	}
+	switch v := n.(type) {
+	case *List:
+		return v == nil
+	case *SelectStmt:
+		return v == nil
+	case *WithClause:
+		return v == nil
+	case *Alias:
+		return v == nil
+	case *RangeVar:
+		return v == nil
+	case *TypeName:
+		return v == nil
+	case *WindowDef:
+		return v == nil
+	case *OnConflictClause:
+		return v == nil
+	case *InferClause:
+		return v == nil
+	case *TableName:
+		return v == nil
+	case *FuncName:
+		return v == nil
+	}
+	return false
+}
+
+// present reports whether an optional node is set. Converters use TODO
+// for a missing node as well as nil.
+func present(n Node) bool {
+	if _, ok := n.(*TODO); ok {
+		return false
+	}
+	return !isNil(n)
+}
+
+// stringValue returns the text of a String node, or the empty string.
+func stringValue(n Node) string {
+	if s, ok := n.(*String); ok {
+		return s.Str
+	}
+	return ""
+}
+
+// opName returns the operator of an A_Expr, without its schema.
+func opName(list *List) string {
+	if items(list) == 0 {
+		return ""
+	}
+	return stringValue(list.Items[len(list.Items)-1])
+}
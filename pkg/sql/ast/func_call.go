@@ -17,3 +17,82 @@ type FuncCall struct {
 func (n *FuncCall) Pos() int {
 	return n.Location
 }
+
+func (n *FuncCall) Format(buf *TrackedBuffer) {
+	if buf.keywordCall(n) {
+		return
+	}
+	switch {
+	case n.Func != nil:
+		buf.astFormat(n.Func)
+	case items(n.Funcname) > 0:
+		for i, item := range n.Funcname.Items {
+			if i > 0 {
+				buf.WriteString(".")
+			}
+			buf.WriteString(stringValue(item))
+		}
+	}
+	buf.WriteString("(")
+	if n.AggDistinct {
+		buf.WriteString("DISTINCT ")
+	}
+	if n.AggStar {
+		buf.WriteString("*")
+	} else {
+		if n.FuncVariadic && items(n.Args) > 0 {
+			last := len(n.Args.Items) - 1
+			buf.join(&List{Items: n.Args.Items[:last]}, ", ")
+			if last > 0 {
+				buf.WriteString(", ")
+			}
+			buf.WriteString("VARIADIC ")
+			buf.astFormat(n.Args.Items[last])
+		} else {
+			buf.join(n.Args, ", ")
+		}
+	}
+	if items(n.AggOrder) > 0 && !n.AggWithinGroup {
+		buf.WriteString(" ORDER BY ")
+		buf.join(n.AggOrder, ", ")
+	}
+	buf.WriteString(")")
+	if items(n.AggOrder) > 0 && n.AggWithinGroup {
+		buf.WriteString(" WITHIN GROUP (ORDER BY ")
+		buf.join(n.AggOrder, ", ")
+		buf.WriteString(")")
+	}
+	if present(n.AggFilter) {
+		buf.WriteString(" FILTER (WHERE ")
+		buf.astFormat(n.AggFilter)
+		buf.WriteString(")")
+	}
+	if n.Over != nil {
+		buf.WriteString(" OVER ")
+		buf.astFormat(n.Over)
+	}
+}
+
+// keywordCall writes a call the dialect spells with keywords instead of a
+// list of arguments, and reports whether it did.
+func (buf *TrackedBuffer) keywordCall(n *FuncCall) bool {
+	if n.AggStar || n.AggDistinct || n.FuncVariadic || items(n.AggOrder) > 0 || present(n.AggFilter) || n.Over != nil {
+		return false
+	}
+	var args []string
+	if n.Args != nil {
+		for _, arg := range n.Args.Items {
+			b := &TrackedBuffer{dialect: buf.dialect}
+			b.paren(arg)
+			if b.err != nil {
+				return false
+			}
+			args = append(args, b.String())
+		}
+	}
+	call, ok := buf.dialect.FuncCall(n, args)
+	if ok {
+		buf.WriteString(call)
+	}
+	return ok
+}
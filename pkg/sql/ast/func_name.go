@@ -9,3 +9,11 @@ type FuncName struct {
 func (n *FuncName) Pos() int {
 	return 0
 }
+
+func (n *FuncName) Format(buf *TrackedBuffer) {
+	if n.Schema != "" {
+		buf.WriteString(n.Schema)
+		buf.WriteString(".")
+	}
+	buf.WriteString(n.Name)
+}
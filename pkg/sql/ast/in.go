@@ -17,3 +17,18 @@ type In struct {
 func (n *In) Pos() int {
 	return n.Location
 }
+
+func (n *In) Format(buf *TrackedBuffer) {
+	buf.paren(n.Expr)
+	if n.Not {
+		buf.WriteString(" NOT IN (")
+	} else {
+		buf.WriteString(" IN (")
+	}
+	if present(n.Sel) {
+		buf.astFormat(n.Sel)
+	} else {
+		buf.join(&List{Items: n.List}, ", ")
+	}
+	buf.WriteString(")")
+}
@@ -13,3 +13,25 @@ type IndexElem struct {
 func (n *IndexElem) Pos() int {
 	return 0
 }
+
+func (n *IndexElem) Format(buf *TrackedBuffer) {
+	if n.Name != nil {
+		buf.ident(*n.Name)
+	} else {
+		buf.WriteString("(")
+		buf.astFormat(n.Expr)
+		buf.WriteString(")")
+	}
+	switch n.Ordering {
+	case SortByDirAsc:
+		buf.WriteString(" ASC")
+	case SortByDirDesc:
+		buf.WriteString(" DESC")
+	}
+	switch n.NullsOrdering {
+	case SortByNullsFirst:
+		buf.WriteString(" NULLS FIRST")
+	case SortByNullsLast:
+		buf.WriteString(" NULLS LAST")
+	}
+}
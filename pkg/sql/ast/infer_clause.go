@@ -10,3 +10,18 @@ type InferClause struct {
 func (n *InferClause) Pos() int {
 	return n.Location
 }
+
+func (n *InferClause) Format(buf *TrackedBuffer) {
+	if n.Conname != nil {
+		buf.WriteString("ON CONSTRAINT ")
+		buf.ident(*n.Conname)
+		return
+	}
+	buf.WriteString("(")
+	buf.join(n.IndexElems, ", ")
+	buf.WriteString(")")
+	if present(n.WhereClause) {
+		buf.WriteString(" WHERE ")
+		buf.astFormat(n.WhereClause)
+	}
+}
@@ -13,3 +13,32 @@ type InsertStmt struct {
 func (n *InsertStmt) Pos() int {
 	return 0
 }
+
+func (n *InsertStmt) Format(buf *TrackedBuffer) {
+	if n.WithClause != nil {
+		buf.astFormat(n.WithClause)
+	}
+	replace := n.OnConflictClause != nil && n.OnConflictClause.Action == OnConflictActionReplace
+	if replace {
+		buf.WriteString("REPLACE INTO ")
+	} else {
+		buf.WriteString("INSERT INTO ")
+	}
+	buf.astFormat(n.Relation)
+	if items(n.Cols) > 0 {
+		buf.WriteString(" (")
+		buf.join(n.Cols, ", ")
+		buf.WriteString(")")
+	}
+	if !present(n.SelectStmt) {
+		buf.WriteString(" DEFAULT VALUES")
+	} else {
+		buf.WriteString(" ")
+		buf.astFormat(n.SelectStmt)
+	}
+	if n.OnConflictClause != nil && !replace {
+		buf.WriteString(" ")
+		buf.astFormat(n.OnConflictClause)
+	}
+	buf.clause("RETURNING", n.ReturningList)
+}
@@ -1,5 +1,9 @@
 package ast
 
+import (
+	"strconv"
+)
+
 type Integer struct {
 	Ival int64
 }
@@ -7,3 +11,7 @@ type Integer struct {
 func (n *Integer) Pos() int {
 	return 0
 }
+
+func (n *Integer) Format(buf *TrackedBuffer) {
+	buf.WriteString(strconv.FormatInt(n.Ival, 10))
+}
@@ -14,3 +14,46 @@ type JoinExpr struct {
 func (n *JoinExpr) Pos() int {
 	return 0
 }
+
+func (n *JoinExpr) Format(buf *TrackedBuffer) {
+	buf.astFormat(n.Larg)
+	buf.WriteString(" ")
+	if n.IsNatural {
+		buf.WriteString("NATURAL ")
+	}
+	switch n.Jointype {
+	case JoinTypeInner:
+		if !present(n.Quals) && items(n.UsingClause) == 0 && !n.IsNatural {
+			buf.WriteString("CROSS ")
+		}
+	case JoinTypeLeft:
+		buf.WriteString("LEFT ")
+	case JoinTypeFull:
+		buf.WriteString("FULL ")
+	case JoinTypeRight:
+		buf.WriteString("RIGHT ")
+	default:
+		buf.unsupported(n)
+	}
+	buf.WriteString("JOIN ")
+	if _, ok := n.Rarg.(*JoinExpr); ok {
+		buf.WriteString("(")
+		buf.astFormat(n.Rarg)
+		buf.WriteString(")")
+	} else {
+		buf.astFormat(n.Rarg)
+	}
+	switch {
+	case items(n.UsingClause) > 0:
+		buf.WriteString(" USING (")
+		buf.join(n.UsingClause, ", ")
+		buf.WriteString(")")
+	case present(n.Quals):
+		buf.WriteString(" ON ")
+		buf.astFormat(n.Quals)
+	}
+	if n.Alias != nil {
+		buf.WriteString(" AS ")
+		buf.astFormat(n.Alias)
+	}
+}
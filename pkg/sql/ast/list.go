@@ -7,3 +7,7 @@ type List struct {
 func (n *List) Pos() int {
 	return 0
 }
+
+func (n *List) Format(buf *TrackedBuffer) {
+	buf.join(n, ", ")
+}
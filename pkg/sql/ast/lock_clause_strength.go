@@ -1,5 +1,15 @@
 package ast
 
+// Enum copies the values of the pg_query protobuf
+const (
+	_ LockClauseStrength = iota
+	LockClauseStrengthNone
+	LockClauseStrengthForKeyShare
+	LockClauseStrengthForShare
+	LockClauseStrengthForNoKeyUpdate
+	LockClauseStrengthForUpdate
+)
+
 type LockClauseStrength uint
 
 func (n *LockClauseStrength) Pos() int {
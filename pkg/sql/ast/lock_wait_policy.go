@@ -1,5 +1,13 @@
 package ast
 
+// Enum copies the values of the pg_query protobuf
+const (
+	_ LockWaitPolicy = iota
+	LockWaitPolicyBlock
+	LockWaitPolicySkip
+	LockWaitPolicyError
+)
+
 type LockWaitPolicy uint
 
 func (n *LockWaitPolicy) Pos() int {
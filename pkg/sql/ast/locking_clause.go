@@ -9,3 +9,29 @@ type LockingClause struct {
 func (n *LockingClause) Pos() int {
 	return 0
 }
+
+func (n *LockingClause) Format(buf *TrackedBuffer) {
+	switch n.Strength {
+	case LockClauseStrengthForKeyShare:
+		buf.WriteString("FOR KEY SHARE")
+	case LockClauseStrengthForShare:
+		buf.WriteString("FOR SHARE")
+	case LockClauseStrengthForNoKeyUpdate:
+		buf.WriteString("FOR NO KEY UPDATE")
+	case LockClauseStrengthForUpdate:
+		buf.WriteString("FOR UPDATE")
+	default:
+		buf.unsupported(n)
+		return
+	}
+	if items(n.LockedRels) > 0 {
+		buf.WriteString(" OF ")
+		buf.join(n.LockedRels, ", ")
+	}
+	switch n.WaitPolicy {
+	case LockWaitPolicySkip:
+		buf.WriteString(" SKIP LOCKED")
+	case LockWaitPolicyError:
+		buf.WriteString(" NOWAIT")
+	}
+}
@@ -13,3 +13,17 @@ type MinMaxExpr struct {
 func (n *MinMaxExpr) Pos() int {
 	return n.Location
 }
+
+func (n *MinMaxExpr) Format(buf *TrackedBuffer) {
+	switch n.Op {
+	case MinMaxOpGreatest:
+		buf.WriteString("GREATEST(")
+	case MinMaxOpLeast:
+		buf.WriteString("LEAST(")
+	default:
+		buf.unsupported(n)
+		return
+	}
+	buf.join(n.Args, ", ")
+	buf.WriteString(")")
+}
@@ -1,5 +1,12 @@
 package ast
 
+// Enum copies the values of the pg_query protobuf
+const (
+	_ MinMaxOp = iota
+	MinMaxOpGreatest
+	MinMaxOpLeast
+)
+
 type MinMaxOp uint
 
 func (n *MinMaxOp) Pos() int {
@@ -9,3 +9,7 @@ type MultiAssignRef struct {
 func (n *MultiAssignRef) Pos() int {
 	return 0
 }
+
+func (n *MultiAssignRef) Format(buf *TrackedBuffer) {
+	buf.astFormat(n.Source)
+}
@@ -11,3 +11,11 @@ type NamedArgExpr struct {
 func (n *NamedArgExpr) Pos() int {
 	return n.Location
 }
+
+func (n *NamedArgExpr) Format(buf *TrackedBuffer) {
+	if n.Name != nil {
+		buf.ident(*n.Name)
+		buf.WriteString(" => ")
+	}
+	buf.astFormat(n.Arg)
+}
@@ -11,3 +11,15 @@ type NullTest struct {
 func (n *NullTest) Pos() int {
 	return n.Location
 }
+
+func (n *NullTest) Format(buf *TrackedBuffer) {
+	buf.paren(n.Arg)
+	switch n.Nulltesttype {
+	case NullTestTypeIsNull:
+		buf.WriteString(" IS NULL")
+	case NullTestTypeIsNotNull:
+		buf.WriteString(" IS NOT NULL")
+	default:
+		buf.unsupported(n)
+	}
+}
@@ -1,5 +1,12 @@
 package ast
 
+// Enum copies the values of the pg_query protobuf
+const (
+	_ NullTestType = iota
+	NullTestTypeIsNull
+	NullTestTypeIsNotNull
+)
+
 type NullTestType uint
 
 func (n *NullTestType) Pos() int {
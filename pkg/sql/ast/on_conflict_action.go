@@ -1,5 +1,17 @@
 package ast
 
+// Enum copies the values of the pg_query protobuf
+const (
+	_ OnConflictAction = iota
+	OnConflictActionNone
+	OnConflictActionNothing
+	OnConflictActionUpdate
+
+	// OnConflictActionReplace is not part of the protobuf. SQLite and MySQL
+	// delete the conflicting rows before inserting.
+	OnConflictActionReplace
+)
+
 type OnConflictAction uint
 
 func (n *OnConflictAction) Pos() int {
@@ -5,9 +5,46 @@ type OnConflictClause struct {
 	Infer       *InferClause
 	TargetList  *List
 	WhereClause Node
-	Location    int
+	// MySQL's alias of the proposed row, as in VALUES (...) AS new(a, b)
+	RowAlias *Alias
+	Location int
 }
 
 func (n *OnConflictClause) Pos() int {
 	return n.Location
 }
+
+func (n *OnConflictClause) Format(buf *TrackedBuffer) {
+	if buf.dialect.OnDuplicateKey() {
+		if n.Action != OnConflictActionUpdate || n.Infer != nil || present(n.WhereClause) {
+			buf.unsupported(n)
+			return
+		}
+		if n.RowAlias != nil {
+			buf.WriteString("AS ")
+			buf.astFormat(n.RowAlias)
+			buf.WriteString(" ")
+		}
+		buf.WriteString("ON DUPLICATE KEY UPDATE ")
+		formatAssignments(buf, n.TargetList)
+		return
+	}
+	buf.WriteString("ON CONFLICT")
+	if n.Infer != nil {
+		buf.WriteString(" ")
+		buf.astFormat(n.Infer)
+	}
+	switch n.Action {
+	case OnConflictActionNothing:
+		buf.WriteString(" DO NOTHING")
+	case OnConflictActionUpdate:
+		buf.WriteString(" DO UPDATE SET ")
+		formatAssignments(buf, n.TargetList)
+		if present(n.WhereClause) {
+			buf.WriteString(" WHERE ")
+			buf.astFormat(n.WhereClause)
+		}
+	default:
+		buf.unsupported(n)
+	}
+}
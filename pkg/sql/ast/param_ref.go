@@ -9,3 +9,7 @@ type ParamRef struct {
 func (n *ParamRef) Pos() int {
 	return n.Location
 }
+
+func (n *ParamRef) Format(buf *TrackedBuffer) {
+	buf.WriteString(buf.dialect.Param(n.Number))
+}
@@ -12,3 +12,26 @@ type RangeFunction struct {
 func (n *RangeFunction) Pos() int {
 	return 0
 }
+
+func (n *RangeFunction) Format(buf *TrackedBuffer) {
+	if n.Lateral {
+		buf.WriteString("LATERAL ")
+	}
+	if n.IsRowsfrom || items(n.Functions) != 1 || items(n.Coldeflist) > 0 {
+		buf.unsupported(n)
+		return
+	}
+	// Each function is a list of the call and its column definitions
+	fn := n.Functions.Items[0]
+	if l, ok := fn.(*List); ok && len(l.Items) > 0 {
+		fn = l.Items[0]
+	}
+	buf.astFormat(fn)
+	if n.Ordinality {
+		buf.WriteString(" WITH ORDINALITY")
+	}
+	if n.Alias != nil {
+		buf.WriteString(" AS ")
+		buf.astFormat(n.Alias)
+	}
+}
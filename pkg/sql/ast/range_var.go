@@ -13,3 +13,17 @@ type RangeVar struct {
 func (n *RangeVar) Pos() int {
 	return n.Location
 }
+
+func (n *RangeVar) Format(buf *TrackedBuffer) {
+	if n.Schemaname != nil && *n.Schemaname != "" {
+		buf.ident(*n.Schemaname)
+		buf.WriteString(".")
+	}
+	if n.Relname != nil {
+		buf.ident(*n.Relname)
+	}
+	if n.Alias != nil {
+		buf.WriteString(" AS ")
+		buf.astFormat(n.Alias)
+	}
+}
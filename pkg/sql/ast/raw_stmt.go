@@ -9,3 +9,7 @@ type RawStmt struct {
 func (n *RawStmt) Pos() int {
 	return n.StmtLocation
 }
+
+func (n *RawStmt) Format(buf *TrackedBuffer) {
+	buf.astFormat(n.Stmt)
+}
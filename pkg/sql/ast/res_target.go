@@ -10,3 +10,19 @@ type ResTarget struct {
 func (n *ResTarget) Pos() int {
 	return n.Location
 }
+
+// Format writes the target as an item of a select list, or as a column name
+// when it has no value.
+func (n *ResTarget) Format(buf *TrackedBuffer) {
+	if !present(n.Val) {
+		if n.Name != nil {
+			buf.ident(*n.Name)
+		}
+		return
+	}
+	buf.astFormat(n.Val)
+	if n.Name != nil {
+		buf.WriteString(" AS ")
+		buf.ident(*n.Name)
+	}
+}
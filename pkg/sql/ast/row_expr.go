@@ -12,3 +12,12 @@ type RowExpr struct {
 func (n *RowExpr) Pos() int {
 	return n.Location
 }
+
+func (n *RowExpr) Format(buf *TrackedBuffer) {
+	if n.RowFormat == CoercionFormExplicitCall {
+		buf.WriteString("ROW")
+	}
+	buf.WriteString("(")
+	buf.join(n.Args, ", ")
+	buf.WriteString(")")
+}
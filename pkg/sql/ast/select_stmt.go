@@ -24,3 +24,103 @@ type SelectStmt struct {
 func (n *SelectStmt) Pos() int {
 	return 0
 }
+
+func (n *SelectStmt) Format(buf *TrackedBuffer) {
+	if n.WithClause != nil {
+		buf.astFormat(n.WithClause)
+	}
+	switch {
+	case items(n.ValuesLists) > 0:
+		buf.WriteString("VALUES ")
+		for i, row := range n.ValuesLists.Items {
+			if i > 0 {
+				buf.WriteString(", ")
+			}
+			buf.WriteString("(")
+			buf.astFormat(row)
+			buf.WriteString(")")
+		}
+
+	case n.Op != None:
+		buf.astFormat(n.Larg)
+		switch n.Op {
+		case Union:
+			buf.WriteString(" UNION ")
+		case Intersect:
+			buf.WriteString(" INTERSECT ")
+		case Except:
+			buf.WriteString(" EXCEPT ")
+		default:
+			buf.unsupported(n)
+		}
+		if n.All {
+			buf.WriteString("ALL ")
+		}
+		buf.astFormat(n.Rarg)
+
+	default:
+		buf.WriteString("SELECT")
+		if items(n.DistinctClause) > 0 {
+			buf.WriteString(" DISTINCT")
+			var on []Node
+			for _, item := range n.DistinctClause.Items {
+				if present(item) {
+					on = append(on, item)
+				}
+			}
+			if len(on) > 0 {
+				buf.WriteString(" ON (")
+				buf.join(&List{Items: on}, ", ")
+				buf.WriteString(")")
+			}
+		}
+		if items(n.TargetList) > 0 {
+			buf.WriteString(" ")
+			buf.join(n.TargetList, ", ")
+		}
+		if n.IntoClause != nil {
+			buf.unsupported(n.IntoClause)
+		}
+		buf.clause("FROM", n.FromClause)
+		if present(n.WhereClause) {
+			buf.WriteString(" WHERE ")
+			buf.astFormat(n.WhereClause)
+		}
+		buf.clause("GROUP BY", n.GroupClause)
+		if present(n.HavingClause) {
+			buf.WriteString(" HAVING ")
+			buf.astFormat(n.HavingClause)
+		}
+		if items(n.WindowClause) > 0 {
+			buf.WriteString(" WINDOW ")
+			for i, item := range n.WindowClause.Items {
+				if i > 0 {
+					buf.WriteString(", ")
+				}
+				w, ok := item.(*WindowDef)
+				if !ok || w.Name == nil {
+					buf.unsupported(item)
+					continue
+				}
+				buf.ident(*w.Name)
+				buf.WriteString(" AS ")
+				def := *w
+				def.Name = nil
+				buf.astFormat(&def)
+			}
+		}
+	}
+	buf.clause("ORDER BY", n.SortClause)
+	if present(n.LimitCount) {
+		buf.WriteString(" LIMIT ")
+		buf.astFormat(n.LimitCount)
+	}
+	if present(n.LimitOffset) {
+		buf.WriteString(" OFFSET ")
+		buf.astFormat(n.LimitOffset)
+	}
+	if items(n.LockingClause) > 0 {
+		buf.WriteString(" ")
+		buf.join(n.LockingClause, " ")
+	}
+}
@@ -11,3 +11,7 @@ type SetToDefault struct {
 func (n *SetToDefault) Pos() int {
 	return n.Location
 }
+
+func (n *SetToDefault) Format(buf *TrackedBuffer) {
+	buf.WriteString("DEFAULT")
+}
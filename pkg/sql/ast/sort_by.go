@@ -11,3 +11,22 @@ type SortBy struct {
 func (n *SortBy) Pos() int {
 	return n.Location
 }
+
+func (n *SortBy) Format(buf *TrackedBuffer) {
+	buf.astFormat(n.Node)
+	switch n.SortbyDir {
+	case SortByDirAsc:
+		buf.WriteString(" ASC")
+	case SortByDirDesc:
+		buf.WriteString(" DESC")
+	case SortByDirUsing:
+		buf.WriteString(" USING ")
+		buf.WriteString(opName(n.UseOp))
+	}
+	switch n.SortbyNulls {
+	case SortByNullsFirst:
+		buf.WriteString(" NULLS FIRST")
+	case SortByNullsLast:
+		buf.WriteString(" NULLS LAST")
+	}
+}
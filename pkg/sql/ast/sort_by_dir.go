@@ -1,5 +1,14 @@
 package ast
 
+// Enum copies the values of the pg_query protobuf
+const (
+	_ SortByDir = iota
+	SortByDirDefault
+	SortByDirAsc
+	SortByDirDesc
+	SortByDirUsing
+)
+
 type SortByDir uint
 
 func (n *SortByDir) Pos() int {
@@ -1,5 +1,13 @@
 package ast
 
+// Enum copies the values of the pg_query protobuf
+const (
+	_ SortByNulls = iota
+	SortByNullsDefault
+	SortByNullsFirst
+	SortByNullsLast
+)
+
 type SortByNulls uint
 
 func (n *SortByNulls) Pos() int {
@@ -1,5 +1,9 @@
 package ast
 
+import (
+	"fmt"
+)
+
 type SQLValueFunction struct {
 	Xpr      Node
 	Op       SQLValueFunctionOp
@@ -11,3 +15,40 @@ type SQLValueFunction struct {
 func (n *SQLValueFunction) Pos() int {
 	return n.Location
 }
+
+func (n *SQLValueFunction) Format(buf *TrackedBuffer) {
+	switch n.Op {
+	case SQLValueFunctionOpCurrentDate:
+		buf.WriteString("CURRENT_DATE")
+	case SQLValueFunctionOpCurrentTime, SQLValueFunctionOpCurrentTimeN:
+		buf.WriteString("CURRENT_TIME")
+	case SQLValueFunctionOpCurrentTimestamp, SQLValueFunctionOpCurrentTimestampN:
+		buf.WriteString("CURRENT_TIMESTAMP")
+	case SQLValueFunctionOpLocaltime, SQLValueFunctionOpLocaltimeN:
+		buf.WriteString("LOCALTIME")
+	case SQLValueFunctionOpLocaltimestamp, SQLValueFunctionOpLocaltimestampN:
+		buf.WriteString("LOCALTIMESTAMP")
+	case SQLValueFunctionOpCurrentRole:
+		buf.WriteString("CURRENT_ROLE")
+	case SQLValueFunctionOpCurrentUser:
+		buf.WriteString("CURRENT_USER")
+	case SQLValueFunctionOpUser:
+		buf.WriteString("USER")
+	case SQLValueFunctionOpSessionUser:
+		buf.WriteString("SESSION_USER")
+	case SQLValueFunctionOpCurrentCatalog:
+		buf.WriteString("CURRENT_CATALOG")
+	case SQLValueFunctionOpCurrentSchema:
+		buf.WriteString("CURRENT_SCHEMA")
+	default:
+		buf.unsupported(n)
+		return
+	}
+	if n.Typmod >= 0 {
+		switch n.Op {
+		case SQLValueFunctionOpCurrentTimeN, SQLValueFunctionOpCurrentTimestampN,
+			SQLValueFunctionOpLocaltimeN, SQLValueFunctionOpLocaltimestampN:
+			buf.WriteString(fmt.Sprintf("(%d)", n.Typmod))
+		}
+	}
+}
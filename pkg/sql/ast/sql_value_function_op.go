@@ -1,5 +1,25 @@
 package ast
 
+// Enum copies the values of the pg_query protobuf
+const (
+	_ SQLValueFunctionOp = iota
+	SQLValueFunctionOpCurrentDate
+	SQLValueFunctionOpCurrentTime
+	SQLValueFunctionOpCurrentTimeN
+	SQLValueFunctionOpCurrentTimestamp
+	SQLValueFunctionOpCurrentTimestampN
+	SQLValueFunctionOpLocaltime
+	SQLValueFunctionOpLocaltimeN
+	SQLValueFunctionOpLocaltimestamp
+	SQLValueFunctionOpLocaltimestampN
+	SQLValueFunctionOpCurrentRole
+	SQLValueFunctionOpCurrentUser
+	SQLValueFunctionOpUser
+	SQLValueFunctionOpSessionUser
+	SQLValueFunctionOpCurrentCatalog
+	SQLValueFunctionOpCurrentSchema
+)
+
 type SQLValueFunctionOp uint
 
 func (n *SQLValueFunctionOp) Pos() int {
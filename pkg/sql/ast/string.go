@@ -7,3 +7,7 @@ type String struct {
 func (n *String) Pos() int {
 	return 0
 }
+
+func (n *String) Format(buf *TrackedBuffer) {
+	buf.ident(n.Str)
+}
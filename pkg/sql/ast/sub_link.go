@@ -26,3 +26,30 @@ type SubLink struct {
 func (n *SubLink) Pos() int {
 	return n.Location
 }
+
+func (n *SubLink) Format(buf *TrackedBuffer) {
+	switch n.SubLinkType {
+	case EXISTS_SUBLINK:
+		buf.WriteString("EXISTS (")
+	case EXPR_SUBLINK:
+		buf.WriteString("(")
+	case ARRAY_SUBLINK:
+		buf.WriteString("ARRAY(")
+	case ANY_SUBLINK, ALL_SUBLINK:
+		buf.paren(n.Testexpr)
+		op := opName(n.OperName)
+		switch {
+		case n.SubLinkType == ANY_SUBLINK && op == "":
+			buf.WriteString(" IN (")
+		case n.SubLinkType == ANY_SUBLINK:
+			buf.WriteString(" " + op + " ANY (")
+		default:
+			buf.WriteString(" " + op + " ALL (")
+		}
+	default:
+		buf.unsupported(n)
+		return
+	}
+	buf.astFormat(n.Subselect)
+	buf.WriteString(")")
+}
@@ -9,3 +9,11 @@ type TableName struct {
 func (n *TableName) Pos() int {
 	return 0
 }
+
+func (n *TableName) Format(buf *TrackedBuffer) {
+	if n.Schema != "" {
+		buf.ident(n.Schema)
+		buf.WriteString(".")
+	}
+	buf.ident(n.Name)
+}
@@ -9,3 +9,15 @@ type TypeCast struct {
 func (n *TypeCast) Pos() int {
 	return n.Location
 }
+
+func (n *TypeCast) Format(buf *TrackedBuffer) {
+	arg := &TrackedBuffer{dialect: buf.dialect}
+	arg.paren(n.Arg)
+	typ := &TrackedBuffer{dialect: buf.dialect}
+	typ.astFormat(n.TypeName)
+	if arg.err != nil || typ.err != nil {
+		buf.unsupported(n)
+		return
+	}
+	buf.WriteString(buf.dialect.Cast(arg.String(), typ.String()))
+}
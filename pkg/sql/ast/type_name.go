@@ -1,5 +1,9 @@
 package ast
 
+import (
+	"fmt"
+)
+
 type TypeName struct {
 	Catalog string
 	Schema  string
@@ -14,8 +18,43 @@ type TypeName struct {
 	Typemod     int32
 	ArrayBounds *List
 	Location    int
+
+	// MySQL's UNSIGNED integer types
+	Unsigned bool
 }
 
 func (n *TypeName) Pos() int {
 	return n.Location
 }
+
+func (n *TypeName) Format(buf *TrackedBuffer) {
+	if n.Setof {
+		buf.WriteString("SETOF ")
+	}
+	ns, name := n.Schema, n.Name
+	if items(n.Names) > 0 {
+		names := n.Names.Items
+		name = stringValue(names[len(names)-1])
+		if len(names) > 1 {
+			ns = stringValue(names[len(names)-2])
+		}
+	}
+	buf.WriteString(buf.dialect.TypeName(ns, name))
+	if items(n.Typmods) > 0 {
+		buf.WriteString("(")
+		buf.join(n.Typmods, ", ")
+		buf.WriteString(")")
+	}
+	if n.Unsigned {
+		buf.WriteString(" unsigned")
+	}
+	if n.ArrayBounds != nil {
+		for _, bound := range n.ArrayBounds.Items {
+			if i, ok := bound.(*Integer); ok && i.Ival >= 0 {
+				buf.WriteString(fmt.Sprintf("[%d]", i.Ival))
+			} else {
+				buf.WriteString("[]")
+			}
+		}
+	}
+}
@@ -12,3 +12,63 @@ type UpdateStmt struct {
 func (n *UpdateStmt) Pos() int {
 	return 0
 }
+
+func (n *UpdateStmt) Format(buf *TrackedBuffer) {
+	if n.WithClause != nil {
+		buf.astFormat(n.WithClause)
+	}
+	buf.WriteString("UPDATE ")
+	buf.join(n.Relations, ", ")
+	buf.WriteString(" SET ")
+	formatAssignments(buf, n.TargetList)
+	buf.clause("FROM", n.FromClause)
+	if present(n.WhereClause) {
+		buf.WriteString(" WHERE ")
+		buf.astFormat(n.WhereClause)
+	}
+	buf.clause("RETURNING", n.ReturningList)
+}
+
+// formatAssignments writes the SET list of an UPDATE. Targets assigned from
+// the same row, as in SET (a, b) = (SELECT ...), are grouped together.
+func formatAssignments(buf *TrackedBuffer, list *List) {
+	if list == nil {
+		return
+	}
+	for i := 0; i < len(list.Items); i++ {
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+		target, ok := list.Items[i].(*ResTarget)
+		if !ok || target.Name == nil {
+			buf.unsupported(list.Items[i])
+			return
+		}
+		ref, ok := target.Val.(*MultiAssignRef)
+		if !ok {
+			buf.ident(*target.Name)
+			buf.WriteString(" = ")
+			buf.astFormat(target.Val)
+			continue
+		}
+		if i+ref.Ncolumns > len(list.Items) {
+			buf.unsupported(ref)
+			return
+		}
+		buf.WriteString("(")
+		for j := 0; j < ref.Ncolumns; j++ {
+			if j > 0 {
+				buf.WriteString(", ")
+			}
+			col, ok := list.Items[i+j].(*ResTarget)
+			if !ok || col.Name == nil {
+				buf.unsupported(list.Items[i+j])
+				return
+			}
+			buf.ident(*col.Name)
+		}
+		buf.WriteString(") = ")
+		buf.astFormat(ref.Source)
+		i += ref.Ncolumns - 1
+	}
+}
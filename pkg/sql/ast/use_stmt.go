@@ -0,0 +1,14 @@
+package ast
+
+type UseStmt struct {
+	Schema string
+}
+
+func (n *UseStmt) Pos() int {
+	return 0
+}
+
+func (n *UseStmt) Format(buf *TrackedBuffer) {
+	buf.WriteString("USE ")
+	buf.WriteString(n.Schema)
+}
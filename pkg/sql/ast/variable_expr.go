@@ -0,0 +1,22 @@
+package ast
+
+// VariableExpr is a MySQL user variable, such as @total, or a system
+// variable, such as @@sql_mode.
+type VariableExpr struct {
+	Name     string
+	IsSystem bool
+	Location int
+}
+
+func (n *VariableExpr) Pos() int {
+	return n.Location
+}
+
+func (n *VariableExpr) Format(buf *TrackedBuffer) {
+	if n.IsSystem {
+		buf.WriteString("@@")
+	} else {
+		buf.WriteString("@")
+	}
+	buf.WriteString(n.Name)
+}
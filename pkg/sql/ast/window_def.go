@@ -14,3 +14,53 @@ type WindowDef struct {
 func (n *WindowDef) Pos() int {
 	return n.Location
 }
+
+// Frame options copy the FRAMEOPTION flags of PostgreSQL
+const (
+	FrameOptionNonDefault              = 0x00001
+	FrameOptionRange                   = 0x00002
+	FrameOptionRows                    = 0x00004
+	FrameOptionGroups                  = 0x00008
+	FrameOptionBetween                 = 0x00010
+	FrameOptionStartUnboundedPreceding = 0x00020
+	FrameOptionEndUnboundedPreceding   = 0x00040
+	FrameOptionStartUnboundedFollowing = 0x00080
+	FrameOptionEndUnboundedFollowing   = 0x00100
+	FrameOptionStartCurrentRow         = 0x00200
+	FrameOptionEndCurrentRow           = 0x00400
+	FrameOptionStartOffsetPreceding    = 0x00800
+	FrameOptionEndOffsetPreceding      = 0x01000
+	FrameOptionStartOffsetFollowing    = 0x02000
+	FrameOptionEndOffsetFollowing      = 0x04000
+	FrameOptionExcludeCurrentRow       = 0x08000
+	FrameOptionExcludeGroup            = 0x10000
+	FrameOptionExcludeTies             = 0x20000
+)
+
+// The frame of a window is only printed when it is the default
+func (n *WindowDef) Format(buf *TrackedBuffer) {
+	if n.FrameOptions&FrameOptionNonDefault != 0 {
+		buf.unsupported(n)
+		return
+	}
+	if n.Name != nil && items(n.PartitionClause) == 0 && items(n.OrderClause) == 0 && n.Refname == nil {
+		buf.ident(*n.Name)
+		return
+	}
+	buf.WriteString("(")
+	sep := ""
+	if n.Refname != nil {
+		buf.ident(*n.Refname)
+		sep = " "
+	}
+	if items(n.PartitionClause) > 0 {
+		buf.WriteString(sep + "PARTITION BY ")
+		buf.join(n.PartitionClause, ", ")
+		sep = " "
+	}
+	if items(n.OrderClause) > 0 {
+		buf.WriteString(sep + "ORDER BY ")
+		buf.join(n.OrderClause, ", ")
+	}
+	buf.WriteString(")")
+}
@@ -9,3 +9,12 @@ type WithClause struct {
 func (n *WithClause) Pos() int {
 	return n.Location
 }
+
+func (n *WithClause) Format(buf *TrackedBuffer) {
+	buf.WriteString("WITH ")
+	if n.Recursive {
+		buf.WriteString("RECURSIVE ")
+	}
+	buf.join(n.Ctes, ", ")
+	buf.WriteString(" ")
+}
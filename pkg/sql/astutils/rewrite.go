@@ -171,9 +171,15 @@ func (a *application) apply(parent ast.Node, name string, iter *iterator, n ast.
 	case *ast.DropFunctionStmt:
 		// pass
 
+	case *ast.DropIndexStmt:
+		// pass
+
 	case *ast.DropSchemaStmt:
 		// pass
 
+	case *ast.DropSequenceStmt:
+		// pass
+
 	case *ast.DropTableStmt:
 		// pass
 
@@ -275,6 +281,7 @@ func (a *application) apply(parent ast.Node, name string, iter *iterator, n ast.
 	case *ast.AlterDomainStmt:
 		a.apply(n, "TypeName", nil, n.TypeName)
 		a.apply(n, "Def", nil, n.Def)
+		a.apply(n, "Domain", nil, n.Domain)
 
 	case *ast.AlterEnumStmt:
 		a.apply(n, "TypeName", nil, n.TypeName)
@@ -532,6 +539,8 @@ func (a *application) apply(parent ast.Node, name string, iter *iterator, n ast.
 		a.apply(n, "TypeName", nil, n.TypeName)
 		a.apply(n, "CollClause", nil, n.CollClause)
 		a.apply(n, "Constraints", nil, n.Constraints)
+		a.apply(n, "Name", nil, n.Name)
+		a.apply(n, "Default", nil, n.Default)
 
 	case *ast.CreateEnumStmt:
 		a.apply(n, "TypeName", nil, n.TypeName)
@@ -1161,6 +1170,12 @@ func (a *application) apply(parent ast.Node, name string, iter *iterator, n ast.
 	case *ast.Var:
 		a.apply(n, "Xpr", nil, n.Xpr)
 
+	case *ast.UseStmt:
+		// pass
+
+	case *ast.VariableExpr:
+		// pass
+
 	case *ast.VariableSetStmt:
 		a.apply(n, "Args", nil, n.Args)
 
@@ -85,9 +85,15 @@ func Walk(f Visitor, node ast.Node) {
 	case *ast.DropFunctionStmt:
 		// pass
 
+	case *ast.DropIndexStmt:
+		// pass
+
 	case *ast.DropSchemaStmt:
 		// pass
 
+	case *ast.DropSequenceStmt:
+		// pass
+
 	case *ast.DropTableStmt:
 		// pass
 
@@ -247,6 +253,9 @@ func Walk(f Visitor, node ast.Node) {
 		if n.Def != nil {
 			Walk(f, n.Def)
 		}
+		if n.Domain != nil {
+			Walk(f, n.Domain)
+		}
 
 	case *ast.AlterEnumStmt:
 		if n.TypeName != nil {
@@ -750,6 +759,12 @@ func Walk(f Visitor, node ast.Node) {
 		if n.Constraints != nil {
 			Walk(f, n.Constraints)
 		}
+		if n.Name != nil {
+			Walk(f, n.Name)
+		}
+		if n.Default != nil {
+			Walk(f, n.Default)
+		}
 
 	case *ast.CreateEnumStmt:
 		if n.TypeName != nil {
@@ -2045,6 +2060,12 @@ func Walk(f Visitor, node ast.Node) {
 			Walk(f, n.Xpr)
 		}
 
+	case *ast.UseStmt:
+		// pass
+
+	case *ast.VariableExpr:
+		// pass
+
 	case *ast.VariableSetStmt:
 		if n.Args != nil {
 			Walk(f, n.Args)
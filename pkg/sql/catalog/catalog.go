@@ -49,6 +49,9 @@ func (c *Catalog) Update(stmt ast.Statement, colGen columnGenerator) error {
 	var err error
 	switch n := stmt.Raw.Stmt.(type) {
 
+	case *ast.AlterDomainStmt:
+		err = c.alterDomain(n)
+
 	case *ast.AlterTableStmt:
 		err = c.alterTable(n)
 
@@ -76,6 +79,9 @@ func (c *Catalog) Update(stmt ast.Statement, colGen columnGenerator) error {
 	case *ast.CompositeTypeStmt:
 		err = c.createCompositeType(n)
 
+	case *ast.CreateDomainStmt:
+		err = c.createDomain(n)
+
 	case *ast.CreateEnumStmt:
 		err = c.createEnum(n)
 
@@ -85,9 +91,15 @@ func (c *Catalog) Update(stmt ast.Statement, colGen columnGenerator) error {
 	case *ast.CreateFunctionStmt:
 		err = c.createFunction(n)
 
+	case *ast.IndexStmt:
+		err = c.createIndex(n)
+
 	case *ast.CreateSchemaStmt:
 		err = c.createSchema(n)
 
+	case *ast.CreateSeqStmt:
+		err = c.createSequence(n)
+
 	case *ast.CreateTableStmt:
 		err = c.createTable(n)
 
@@ -100,9 +112,15 @@ func (c *Catalog) Update(stmt ast.Statement, colGen columnGenerator) error {
 	case *ast.DropFunctionStmt:
 		err = c.dropFunction(n)
 
+	case *ast.DropIndexStmt:
+		err = c.dropIndex(n)
+
 	case *ast.DropSchemaStmt:
 		err = c.dropSchema(n)
 
+	case *ast.DropSequenceStmt:
+		err = c.dropSequence(n)
+
 	case *ast.DropTableStmt:
 		err = c.dropTable(n)
 
@@ -118,6 +136,9 @@ func (c *Catalog) Update(stmt ast.Statement, colGen columnGenerator) error {
 	case *ast.RenameTypeStmt:
 		err = c.renameType(n)
 
+	case *ast.UseStmt:
+		err = c.useSchema(n)
+
 	case *ast.List:
 		for _, nn := range n.Items {
 			if err = c.Update(ast.Statement{
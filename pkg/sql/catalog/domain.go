@@ -0,0 +1,163 @@
+package catalog
+
+import (
+	"fmt"
+
+	"github.com/stephenwithav/sqlc/pkg/sql/ast"
+	"github.com/stephenwithav/sqlc/pkg/sql/sqlerr"
+)
+
+// Domain describes a data type based on another type, which can restrict
+// its values with NOT NULL and CHECK constraints
+//
+// https://www.postgresql.org/docs/current/sql-createdomain.html
+type Domain struct {
+	Name     string
+	BaseType ast.TypeName
+	IsArray  bool
+	NotNull  bool
+	Default  ast.Node
+	Checks   []*Check
+	Comment  string
+}
+
+func (d *Domain) isType() {
+}
+
+func (d *Domain) SetComment(c string) {
+	d.Comment = c
+}
+
+// Check is a named CHECK constraint
+type Check struct {
+	Name string
+	Expr ast.Node
+}
+
+func (d *Domain) addCheck(con *ast.Constraint) error {
+	var name string
+	if con.Conname != nil {
+		name = *con.Conname
+	} else {
+		// Unnamed constraints are named like PostgreSQL does
+		name = d.Name + "_check"
+		for i := 1; d.check(name) >= 0; i++ {
+			name = fmt.Sprintf("%s_check%d", d.Name, i)
+		}
+	}
+	if d.check(name) >= 0 {
+		return &sqlerr.Error{
+			Code:    "42710",
+			Message: fmt.Sprintf("constraint \"%s\" for domain \"%s\" already exists", name, d.Name),
+		}
+	}
+	d.Checks = append(d.Checks, &Check{Name: name, Expr: con.RawExpr})
+	return nil
+}
+
+func (d *Domain) check(name string) int {
+	for i, c := range d.Checks {
+		if c.Name == name {
+			return i
+		}
+	}
+	return -1
+}
+
+func (c *Catalog) createDomain(stmt *ast.CreateDomainStmt) error {
+	ns := stmt.Name.Schema
+	if ns == "" {
+		ns = c.DefaultSchema
+	}
+	schema, err := c.getSchema(ns)
+	if err != nil {
+		return err
+	}
+	// Domains share their namespace with types and tables
+	tbl := &ast.TableName{
+		Name: stmt.Name.Name,
+	}
+	if _, _, err := schema.getTable(tbl); err == nil {
+		return sqlerr.RelationExists(tbl.Name)
+	}
+	if _, _, err := schema.getType(stmt.Name); err == nil {
+		return sqlerr.TypeExists(tbl.Name)
+	}
+	// The base type isn't required to exist, but it can't lead back to
+	// the new domain
+	for base := stmt.TypeName; base != nil; {
+		if base.Name == stmt.Name.Name && base.Schema == stmt.Name.Schema {
+			return sqlerr.TypeNotFound(base.Name)
+		}
+		typ, _, err := c.getType(base)
+		if err != nil {
+			break
+		}
+		bd, ok := typ.(*Domain)
+		if !ok {
+			break
+		}
+		base = &bd.BaseType
+	}
+	d := &Domain{
+		Name:     stmt.Name.Name,
+		BaseType: *stmt.TypeName,
+		IsArray:  stmt.IsArray,
+		NotNull:  stmt.IsNotNull,
+		Default:  stmt.Default,
+	}
+	if stmt.Constraints != nil {
+		for _, item := range stmt.Constraints.Items {
+			con, ok := item.(*ast.Constraint)
+			if !ok {
+				continue
+			}
+			if err := d.addCheck(con); err != nil {
+				return err
+			}
+		}
+	}
+	schema.Types = append(schema.Types, d)
+	return nil
+}
+
+func (c *Catalog) alterDomain(stmt *ast.AlterDomainStmt) error {
+	typ, _, err := c.getType(stmt.Domain)
+	if err != nil {
+		return err
+	}
+	d, ok := typ.(*Domain)
+	if !ok {
+		return fmt.Errorf("type is not a domain: %s", stmt.Domain.Name)
+	}
+	switch stmt.Subtype {
+	case 'T':
+		d.Default = stmt.Def
+	case 'N':
+		d.NotNull = false
+	case 'O':
+		d.NotNull = true
+	case 'C':
+		con, ok := stmt.Def.(*ast.Constraint)
+		if !ok {
+			return fmt.Errorf("alter domain: unexpected constraint: %T", stmt.Def)
+		}
+		return d.addCheck(con)
+	case 'X':
+		if stmt.Name == nil {
+			return fmt.Errorf("alter domain: empty constraint name")
+		}
+		i := d.check(*stmt.Name)
+		if i < 0 {
+			if stmt.MissingOk {
+				return nil
+			}
+			return &sqlerr.Error{
+				Code:    "42704",
+				Message: fmt.Sprintf("constraint \"%s\" of domain \"%s\" does not exist", *stmt.Name, d.Name),
+			}
+		}
+		d.Checks = append(d.Checks[:i], d.Checks[i+1:]...)
+	}
+	return nil
+}
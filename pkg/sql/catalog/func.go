@@ -39,6 +39,30 @@ func (f *Function) InArgs() []*Argument {
 	return args
 }
 
+// CallArgs returns the arguments passed to the function by a CALL statement.
+func (f *Function) CallArgs() []*Argument {
+	var args []*Argument
+	for _, a := range f.Args {
+		if a.Mode != ast.FuncParamTable {
+			args = append(args, a)
+		}
+	}
+	return args
+}
+
+// OutArgs returns the arguments which make up the rows returned by the
+// function, as declared by OUT parameters or RETURNS TABLE.
+func (f *Function) OutArgs() []*Argument {
+	var args []*Argument
+	for _, a := range f.Args {
+		switch a.Mode {
+		case ast.FuncParamTable, ast.FuncParamOut, ast.FuncParamInOut:
+			args = append(args, a)
+		}
+	}
+	return args
+}
+
 func (c *Catalog) getFunc(rel *ast.FuncName, tns []*ast.TypeName) (*Function, int, error) {
 	ns := rel.Schema
 	if ns == "" {
@@ -65,7 +89,7 @@ func (c *Catalog) createFunction(stmt *ast.CreateFunctionStmt) error {
 		Args:       make([]*Argument, len(stmt.Params.Items)),
 		ReturnType: stmt.ReturnType,
 	}
-	types := make([]*ast.TypeName, len(stmt.Params.Items))
+	var types []*ast.TypeName
 	for i, item := range stmt.Params.Items {
 		arg := item.(*ast.FuncParam)
 		var name string
@@ -78,7 +102,12 @@ func (c *Catalog) createFunction(stmt *ast.CreateFunctionStmt) error {
 			Mode:       arg.Mode,
 			HasDefault: arg.DefExpr != nil,
 		}
-		types[i] = arg.Type
+		// Only the input arguments identify a function
+		switch arg.Mode {
+		case ast.FuncParamTable, ast.FuncParamOut:
+		default:
+			types = append(types, arg.Type)
+		}
 	}
 
 	_, idx, err := s.getFunc(stmt.Func, types)
@@ -0,0 +1,139 @@
+package catalog
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/stephenwithav/sqlc/pkg/sql/ast"
+	"github.com/stephenwithav/sqlc/pkg/sql/sqlerr"
+)
+
+// Index describes an index on the columns of a table
+//
+// Columns holds the indexed columns in key order. An index on an expression
+// lists the columns which the expression refers to.
+type Index struct {
+	Name    string
+	Columns []string
+	Unique  bool
+	Primary bool
+}
+
+func (table *Table) getIndex(name string) (*Index, int) {
+	for i := range table.Indexes {
+		if table.Indexes[i].Name == name {
+			return table.Indexes[i], i
+		}
+	}
+	return nil, -1
+}
+
+func (table *Table) addIndex(stmt *ast.IndexStmt) error {
+	index := &Index{
+		Unique:  stmt.Unique || stmt.Primary,
+		Primary: stmt.Primary,
+	}
+	if stmt.IndexParams != nil {
+		for _, item := range stmt.IndexParams.Items {
+			elem, ok := item.(*ast.IndexElem)
+			if !ok {
+				continue
+			}
+			if elem.Name != nil {
+				index.Columns = append(index.Columns, *elem.Name)
+			} else if elem.Expr != nil {
+				index.Columns = append(index.Columns, columnNames(elem.Expr)...)
+			}
+		}
+	}
+	for _, name := range index.Columns {
+		if !table.hasColumn(name) {
+			return sqlerr.ColumnNotFound(table.Rel.Name, name)
+		}
+	}
+	if stmt.Idxname != nil {
+		index.Name = *stmt.Idxname
+	} else {
+		base := defaultIndexName(table.Rel.Name, index)
+		index.Name = base
+		for i := 1; ; i++ {
+			if existing, _ := table.getIndex(index.Name); existing == nil {
+				break
+			}
+			index.Name = fmt.Sprintf("%s%d", base, i)
+		}
+	}
+	if existing, _ := table.getIndex(index.Name); existing != nil {
+		if stmt.IfNotExists {
+			return nil
+		}
+		return sqlerr.RelationExists(index.Name)
+	}
+	table.Indexes = append(table.Indexes, index)
+	return nil
+}
+
+// defaultIndexName follows the naming scheme of PostgreSQL for indexes
+// created without a name.
+func defaultIndexName(table string, index *Index) string {
+	switch {
+	case index.Primary:
+		return table + "_pkey"
+	case index.Unique:
+		return strings.Join(append([]string{table}, index.Columns...), "_") + "_key"
+	default:
+		return strings.Join(append([]string{table}, index.Columns...), "_") + "_idx"
+	}
+}
+
+func (c *Catalog) createIndex(stmt *ast.IndexStmt) error {
+	if stmt.Relation == nil {
+		return errors.New("create index: empty table name")
+	}
+	_, table, err := c.getTable(rangeVarTableName(stmt.Relation))
+	if err != nil {
+		return err
+	}
+	return table.addIndex(stmt)
+}
+
+func (c *Catalog) dropIndex(stmt *ast.DropIndexStmt) error {
+	for _, name := range stmt.Indexes {
+		var tables []*Table
+		if stmt.Table != nil {
+			_, table, err := c.getTable(stmt.Table)
+			if errors.Is(err, sqlerr.NotFound) && stmt.IfExists {
+				continue
+			} else if err != nil {
+				return err
+			}
+			tables = append(tables, table)
+		} else {
+			ns := name.Schema
+			if ns == "" {
+				ns = c.DefaultSchema
+			}
+			schema, err := c.getSchema(ns)
+			if errors.Is(err, sqlerr.NotFound) && stmt.IfExists {
+				continue
+			} else if err != nil {
+				return err
+			}
+			tables = schema.Tables
+		}
+
+		found := false
+		for _, table := range tables {
+			if _, idx := table.getIndex(name.Name); idx >= 0 {
+				table.Indexes = append(table.Indexes[:idx], table.Indexes[idx+1:]...)
+				found = true
+				break
+			}
+		}
+		if !found && !stmt.IfExists {
+			return sqlerr.RelationNotFound(name.Name)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,60 @@
+package catalog
+
+import (
+	"github.com/stephenwithav/sqlc/pkg/sql/ast"
+	"github.com/stephenwithav/sqlc/pkg/sql/lang"
+	"github.com/stephenwithav/sqlc/pkg/sql/sqlerr"
+)
+
+// Operator describes the signature of a built-in operator. Left is nil for a
+// prefix operator.
+type Operator struct {
+	Name       string
+	Left       *ast.TypeName
+	Right      *ast.TypeName
+	ReturnType *ast.TypeName
+}
+
+// ResolveOperator returns the operator applied to operands of the given
+// types. The left type is empty for a prefix operator, and an operand of
+// unknown type, given as "any", takes the type of the other one.
+//
+// An operator declared for the types of the operands is preferred. Failing
+// that, the operands are converted to the types of the first operator which
+// accepts them, so engines declare the narrowest types first.
+func (c *Catalog) ResolveOperator(name, left, right string) (*Operator, error) {
+	if left == "any" {
+		left = right
+	}
+	if right == "any" {
+		right = left
+	}
+	for _, convert := range []bool{false, true} {
+		for _, ns := range c.schemasToSearch("") {
+			s, err := c.getSchema(ns)
+			if err != nil {
+				continue
+			}
+			for _, op := range s.Operators {
+				if op.Name == name && acceptsOperand(op.Left, left, convert) && acceptsOperand(op.Right, right, convert) {
+					return op, nil
+				}
+			}
+		}
+	}
+	return nil, sqlerr.OperatorNotFound(name)
+}
+
+// acceptsOperand reports whether an operand of the type can be passed to an
+// operator declared with the given operand type.
+func acceptsOperand(decl *ast.TypeName, typ string, convert bool) bool {
+	switch {
+	case decl == nil || typ == "":
+		return decl == nil && typ == ""
+	case decl.Name == "any" || lang.IsPolymorphicType(decl.Name):
+		return true
+	case convert:
+		return lang.ConvertsTo(typ, decl.Name)
+	}
+	return lang.SameType(decl.Name, typ)
+}
@@ -12,6 +12,11 @@ func (c *Catalog) schemasToSearch(ns string) []string {
 	if ns == "" {
 		ns = c.DefaultSchema
 	}
+	for _, name := range c.SearchPath {
+		if name == ns {
+			return c.SearchPath
+		}
+	}
 	return append(c.SearchPath, ns)
 }
 
@@ -33,6 +38,16 @@ func (c *Catalog) ListFuncsByName(rel *ast.FuncName) ([]Function, error) {
 }
 
 func (c *Catalog) ResolveFuncCall(call *ast.FuncCall) (*Function, error) {
+	return c.resolveFuncCall(call, (*Function).InArgs)
+}
+
+// ResolveCall returns the procedure called by a CALL statement, which is
+// passed its OUT arguments as well as its input arguments.
+func (c *Catalog) ResolveCall(call *ast.FuncCall) (*Function, error) {
+	return c.resolveFuncCall(call, (*Function).CallArgs)
+}
+
+func (c *Catalog) resolveFuncCall(call *ast.FuncCall, argsOf func(*Function) []*Argument) (*Function, error) {
 	// Do not validate unknown functions
 	funs, err := c.ListFuncsByName(call.Func)
 	if err != nil || len(funs) == 0 {
@@ -64,7 +79,7 @@ func (c *Catalog) ResolveFuncCall(call *ast.FuncCall) (*Function, error) {
 	}
 
 	for _, fun := range funs {
-		args := fun.InArgs()
+		args := argsOf(&fun)
 		var defaults int
 		var variadic bool
 		known := map[string]struct{}{}
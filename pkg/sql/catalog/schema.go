@@ -15,6 +15,11 @@ type Schema struct {
 	Types  []Type
 	Funcs  []*Function
 
+	// Operators are only declared for built-in schemas
+	Operators []*Operator
+
+	Sequences []*Sequence
+
 	Comment string
 }
 
@@ -30,7 +35,7 @@ func (s *Schema) getFunc(rel *ast.FuncName, tns []*ast.TypeName) (*Function, int
 		}
 		found := true
 		for j := range args {
-			if !sameType(s.Funcs[i].Args[j].Type, tns[j]) {
+			if !sameType(args[j].Type, tns[j]) {
 				found = false
 				break
 			}
@@ -77,6 +82,10 @@ func (s *Schema) getType(rel *ast.TypeName) (Type, int, error) {
 			if typ.Name == rel.Name {
 				return s.Types[i], i, nil
 			}
+		case *Domain:
+			if typ.Name == rel.Name {
+				return s.Types[i], i, nil
+			}
 		}
 	}
 	return nil, -1, sqlerr.TypeNotFound(rel.Name)
@@ -99,11 +108,20 @@ func (c *Catalog) createSchema(stmt *ast.CreateSchemaStmt) error {
 		if !stmt.IfNotExists {
 			return sqlerr.SchemaExists(*stmt.Name)
 		}
+		return nil
 	}
 	c.Schemas = append(c.Schemas, &Schema{Name: *stmt.Name})
 	return nil
 }
 
+func (c *Catalog) useSchema(stmt *ast.UseStmt) error {
+	if _, err := c.getSchema(stmt.Schema); err != nil {
+		return err
+	}
+	c.DefaultSchema = stmt.Schema
+	return nil
+}
+
 func (c *Catalog) dropSchema(stmt *ast.DropSchemaStmt) error {
 	// TODO: n^2 in the worst-case
 	for _, name := range stmt.Schemas {
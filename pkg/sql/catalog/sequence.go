@@ -0,0 +1,73 @@
+package catalog
+
+import (
+	"errors"
+
+	"github.com/stephenwithav/sqlc/pkg/sql/ast"
+	"github.com/stephenwithav/sqlc/pkg/sql/sqlerr"
+)
+
+// Sequence describes a generator of unique numbers
+type Sequence struct {
+	Rel *ast.TableName
+}
+
+func (s *Schema) getSequence(rel *ast.TableName) (*Sequence, int, error) {
+	for i := range s.Sequences {
+		if s.Sequences[i].Rel.Name == rel.Name {
+			return s.Sequences[i], i, nil
+		}
+	}
+	return nil, -1, sqlerr.RelationNotFound(rel.Name)
+}
+
+func (c *Catalog) createSequence(stmt *ast.CreateSeqStmt) error {
+	if stmt.Sequence == nil {
+		return errors.New("create sequence: empty name")
+	}
+	rel := rangeVarTableName(stmt.Sequence)
+	ns := rel.Schema
+	if ns == "" {
+		ns = c.DefaultSchema
+	}
+	schema, err := c.getSchema(ns)
+	if err != nil {
+		return err
+	}
+	// Sequences share their namespace with tables
+	_, _, seqErr := schema.getSequence(rel)
+	_, _, tblErr := schema.getTable(rel)
+	if seqErr == nil || tblErr == nil {
+		if stmt.IfNotExists {
+			return nil
+		}
+		return sqlerr.RelationExists(rel.Name)
+	}
+	schema.Sequences = append(schema.Sequences, &Sequence{Rel: rel})
+	return nil
+}
+
+func (c *Catalog) dropSequence(stmt *ast.DropSequenceStmt) error {
+	for _, name := range stmt.Sequences {
+		ns := name.Schema
+		if ns == "" {
+			ns = c.DefaultSchema
+		}
+		schema, err := c.getSchema(ns)
+		if errors.Is(err, sqlerr.NotFound) && stmt.IfExists {
+			continue
+		} else if err != nil {
+			return err
+		}
+
+		_, idx, err := schema.getSequence(name)
+		if errors.Is(err, sqlerr.NotFound) && stmt.IfExists {
+			continue
+		} else if err != nil {
+			return err
+		}
+
+		schema.Sequences = append(schema.Sequences[:idx], schema.Sequences[idx+1:]...)
+	}
+	return nil
+}
@@ -3,8 +3,10 @@ package catalog
 import (
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/stephenwithav/sqlc/pkg/sql/ast"
+	"github.com/stephenwithav/sqlc/pkg/sql/astutils"
 	"github.com/stephenwithav/sqlc/pkg/sql/sqlerr"
 )
 
@@ -13,9 +15,65 @@ import (
 // A database table is a collection of related data held in a table format within a database.
 // It consists of columns and rows.
 type Table struct {
-	Rel     *ast.TableName
-	Columns []*Column
-	Comment string
+	Rel       *ast.TableName
+	Columns   []*Column
+	Indexes   []*Index
+	Partition *Partition
+	Comment   string
+	Strict    bool
+}
+
+// Partition describes how the rows of a table are divided into partitions
+type Partition struct {
+	Strategy string
+	Columns  []string
+}
+
+func (table *Table) hasColumn(name string) bool {
+	for _, c := range table.Columns {
+		if c.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// renameIndexedColumn carries the rename of a column over to the indexes and
+// the partition key of the table.
+func (table *Table) renameIndexedColumn(name, newName string) {
+	for _, index := range table.Indexes {
+		for i := range index.Columns {
+			if index.Columns[i] == name {
+				index.Columns[i] = newName
+			}
+		}
+	}
+	if table.Partition != nil {
+		for i := range table.Partition.Columns {
+			if table.Partition.Columns[i] == name {
+				table.Partition.Columns[i] = newName
+			}
+		}
+	}
+}
+
+// dropIndexedColumn removes a dropped column from the indexes of the table.
+// Indexes without any columns left are dropped as well.
+func (table *Table) dropIndexedColumn(name string) {
+	indexes := table.Indexes[:0]
+	for _, index := range table.Indexes {
+		columns := index.Columns[:0]
+		for _, col := range index.Columns {
+			if col != name {
+				columns = append(columns, col)
+			}
+		}
+		index.Columns = columns
+		if len(columns) > 0 {
+			indexes = append(indexes, index)
+		}
+	}
+	table.Indexes = indexes
 }
 
 func (table *Table) isExistColumn(cmd *ast.AlterTableCmd) (int, error) {
@@ -37,16 +95,44 @@ func (table *Table) addColumn(cmd *ast.AlterTableCmd) error {
 			return sqlerr.ColumnExists(table.Rel.Name, c.Name)
 		}
 	}
+	if table.Strict {
+		if err := checkStrictType(table.Rel, cmd.Def); err != nil {
+			return err
+		}
+	}
 	table.Columns = append(table.Columns, &Column{
-		Name:      cmd.Def.Colname,
-		Type:      *cmd.Def.TypeName,
-		IsNotNull: cmd.Def.IsNotNull,
-		IsArray:   cmd.Def.IsArray,
-		Length:    cmd.Def.Length,
+		Name:        cmd.Def.Colname,
+		Type:        *cmd.Def.TypeName,
+		IsNotNull:   cmd.Def.IsNotNull,
+		IsArray:     cmd.Def.IsArray,
+		Length:      cmd.Def.Length,
+		Collation:   collationName(cmd.Def.CollClause),
+		IsGenerated: cmd.Def.Generated != 0,
+		IsHidden:    cmd.Def.IsHidden,
 	})
 	return nil
 }
 
+// strictTypes are the only column types of a STRICT table in SQLite
+var strictTypes = map[string]bool{
+	"int":     true,
+	"integer": true,
+	"real":    true,
+	"text":    true,
+	"blob":    true,
+	"any":     true,
+}
+
+func checkStrictType(rel *ast.TableName, col *ast.ColumnDef) error {
+	if strictTypes[strings.ToLower(col.TypeName.Name)] {
+		return nil
+	}
+	return &sqlerr.Error{
+		Code:    "42704",
+		Message: fmt.Sprintf("unknown datatype for %s.%s: \"%s\"", rel.Name, col.Colname, col.TypeName.Name),
+	}
+}
+
 func (table *Table) alterColumnType(cmd *ast.AlterTableCmd) error {
 	index, err := table.isExistColumn(cmd)
 	if err != nil {
@@ -66,6 +152,7 @@ func (table *Table) dropColumn(cmd *ast.AlterTableCmd) error {
 	}
 	if index >= 0 {
 		table.Columns = append(table.Columns[:index], table.Columns[index+1:]...)
+		table.dropIndexedColumn(*cmd.Name)
 	}
 	return nil
 }
@@ -102,6 +189,10 @@ type Column struct {
 	IsArray   bool
 	Comment   string
 	Length    *int
+	// Collation is empty when the column uses the default collation
+	Collation   string
+	IsGenerated bool
+	IsHidden    bool
 }
 
 // An interface is used to resolve a circular import between the catalog and compiler packages.
@@ -235,7 +326,7 @@ func (c *Catalog) createTable(stmt *ast.CreateTableStmt) error {
 		return sqlerr.RelationExists(stmt.Name.Name)
 	}
 
-	tbl := Table{Rel: stmt.Name, Comment: stmt.Comment}
+	tbl := Table{Rel: stmt.Name, Comment: stmt.Comment, Strict: stmt.Strict}
 	for _, inheritTable := range stmt.Inherits {
 		t, _, err := schema.getTable(inheritTable)
 		if err != nil {
@@ -257,15 +348,28 @@ func (c *Catalog) createTable(stmt *ast.CreateTableStmt) error {
 			newCol := *col // make a copy, so changes to the ReferTable don't propagate
 			tbl.Columns = append(tbl.Columns, &newCol)
 		}
+		for _, index := range original.Indexes {
+			newIndex := *index
+			newIndex.Columns = append([]string(nil), index.Columns...)
+			tbl.Indexes = append(tbl.Indexes, &newIndex)
+		}
 	} else {
 		for _, col := range stmt.Cols {
+			if stmt.Strict {
+				if err := checkStrictType(stmt.Name, col); err != nil {
+					return err
+				}
+			}
 			tc := &Column{
-				Name:      col.Colname,
-				Type:      *col.TypeName,
-				IsNotNull: col.IsNotNull,
-				IsArray:   col.IsArray,
-				Comment:   col.Comment,
-				Length:    col.Length,
+				Name:        col.Colname,
+				Type:        *col.TypeName,
+				IsNotNull:   col.IsNotNull,
+				IsArray:     col.IsArray,
+				Comment:     col.Comment,
+				Length:      col.Length,
+				Collation:   collationName(col.CollClause),
+				IsGenerated: col.Generated != 0,
+				IsHidden:    col.IsHidden,
 			}
 			if col.Vals != nil {
 				typeName := ast.TypeName{
@@ -275,11 +379,28 @@ func (c *Catalog) createTable(stmt *ast.CreateTableStmt) error {
 				if err := c.createEnum(s); err != nil {
 					return err
 				}
-				tc.Type = typeName
+				// The members of a MySQL SET are an enum too, but the
+				// column holds any number of them
+				if col.TypeName.Name != "set" {
+					tc.Type = typeName
+				}
 			}
 			tbl.Columns = append(tbl.Columns, tc)
 		}
 	}
+	for _, index := range stmt.Indexes {
+		if err := tbl.addIndex(index); err != nil {
+			return err
+		}
+	}
+	if stmt.Partition != nil {
+		tbl.Partition = newPartition(stmt.Partition)
+		for _, name := range tbl.Partition.Columns {
+			if !tbl.hasColumn(name) {
+				return sqlerr.ColumnNotFound(tbl.Rel.Name, name)
+			}
+		}
+	}
 	schema.Tables = append(schema.Tables, &tbl)
 	return nil
 }
@@ -327,6 +448,7 @@ func (c *Catalog) renameColumn(stmt *ast.RenameColumnStmt) error {
 		return sqlerr.ColumnNotFound(tbl.Rel.Name, stmt.Col.Name)
 	}
 	tbl.Columns[idx].Name = *stmt.NewName
+	tbl.renameIndexedColumn(stmt.Col.Name, *stmt.NewName)
 	return nil
 }
 
@@ -385,3 +507,69 @@ func (c *Catalog) createTableAs(stmt *ast.CreateTableAsStmt, colGen columnGenera
 
 	return nil
 }
+
+func newPartition(spec *ast.PartitionSpec) *Partition {
+	part := &Partition{}
+	if spec.Strategy != nil {
+		part.Strategy = *spec.Strategy
+	}
+	if spec.PartParams != nil {
+		for _, item := range spec.PartParams.Items {
+			elem, ok := item.(*ast.PartitionElem)
+			if !ok {
+				continue
+			}
+			if elem.Name != nil {
+				part.Columns = append(part.Columns, *elem.Name)
+			} else if elem.Expr != nil {
+				part.Columns = append(part.Columns, columnNames(elem.Expr)...)
+			}
+		}
+	}
+	return part
+}
+
+// columnNames returns the names of the columns which an expression refers to.
+func columnNames(expr ast.Node) []string {
+	var names []string
+	for _, item := range astutils.Search(expr, func(node ast.Node) bool {
+		_, ok := node.(*ast.ColumnRef)
+		return ok
+	}).Items {
+		ref := item.(*ast.ColumnRef)
+		if ref.Fields == nil || len(ref.Fields.Items) == 0 {
+			continue
+		}
+		if name, ok := ref.Fields.Items[len(ref.Fields.Items)-1].(*ast.String); ok {
+			names = append(names, name.Str)
+		}
+	}
+	return names
+}
+
+func rangeVarTableName(rv *ast.RangeVar) *ast.TableName {
+	name := &ast.TableName{}
+	if rv.Catalogname != nil {
+		name.Catalog = *rv.Catalogname
+	}
+	if rv.Schemaname != nil {
+		name.Schema = *rv.Schemaname
+	}
+	if rv.Relname != nil {
+		name.Name = *rv.Relname
+	}
+	return name
+}
+
+func collationName(clause *ast.CollateClause) string {
+	if clause == nil || clause.Collname == nil {
+		return ""
+	}
+	var parts []string
+	for _, item := range clause.Collname.Items {
+		if s, ok := item.(*ast.String); ok {
+			parts = append(parts, s.Str)
+		}
+	}
+	return strings.Join(parts, ".")
+}
@@ -278,6 +278,11 @@ func (c *Catalog) renameType(stmt *ast.RenameTypeStmt) error {
 			Comment: typ.Comment,
 		}
 
+	case *Domain:
+		d := *typ
+		d.Name = newName
+		schema.Types[idx] = &d
+
 	default:
 		return fmt.Errorf("unsupported type: %T", typ)
 
@@ -0,0 +1,79 @@
+package lang
+
+import "strings"
+
+// Different spellings of the same built-in type.
+var typeAliases = map[string]string{
+	"int2":        "smallint",
+	"smallserial": "smallint",
+	"int":         "integer",
+	"int4":        "integer",
+	"serial":      "integer",
+	"int8":        "bigint",
+	"bigserial":   "bigint",
+	"float4":      "real",
+	"float8":      "double precision",
+	"double":      "double precision",
+	"decimal":     "numeric",
+	"bool":        "boolean",
+	"varchar":     "character varying",
+	"char":        "character",
+	"bpchar":      "character",
+	"timestamp":   "timestamp without time zone",
+	"timestamptz": "timestamp with time zone",
+	"time":        "time without time zone",
+	"timetz":      "time with time zone",
+}
+
+// SameType reports whether two type names refer to the same type.
+func SameType(a, b string) bool {
+	return canonicalType(a) == canonicalType(b)
+}
+
+func canonicalType(t string) string {
+	t = strings.ToLower(baseType(t))
+	if alias, ok := typeAliases[t]; ok {
+		return alias
+	}
+	return t
+}
+
+// IsPolymorphicType reports whether a function argument or result of the
+// type takes the type of the values it is given.
+func IsPolymorphicType(t string) bool {
+	switch baseType(t) {
+	case "anyelement", "anynonarray", "anyenum", "anycompatible", "anycompatiblenonarray":
+		return true
+	}
+	return IsPolymorphicArrayType(t)
+}
+
+// IsPolymorphicArrayType reports whether the type is a polymorphic array of
+// elements.
+func IsPolymorphicArrayType(t string) bool {
+	switch baseType(t) {
+	case "anyarray", "anycompatiblearray":
+		return true
+	}
+	return false
+}
+
+// IsNullableWindowFunction reports whether the window function returns null
+// when its offset falls outside of the window frame.
+func IsNullableWindowFunction(name string) bool {
+	switch strings.ToLower(name) {
+	case "lag", "lead", "first_value", "last_value", "nth_value":
+		return true
+	}
+	return false
+}
+
+// IsNullableAggregate reports whether the aggregate returns null when no
+// rows are aggregated, as happens when a FILTER clause rejects every row.
+func IsNullableAggregate(name string) bool {
+	switch strings.ToLower(name) {
+	case "count", "count_big":
+		return false
+	}
+	return true
+}
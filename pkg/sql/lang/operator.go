@@ -1,6 +1,6 @@
 package lang
 
-// TODO: This logic is PostgreSQL-specific and needs to be refactored to support MySQL
+import "strings"
 
 func IsComparisonOperator(s string) bool {
 	switch s {
@@ -17,6 +17,16 @@ func IsComparisonOperator(s string) bool {
 	return true
 }
 
+// IsRegexMatchOperator reports whether the binary operator matches a string
+// against a regular expression.
+func IsRegexMatchOperator(s string) bool {
+	switch s {
+	case "~", "~*", "!~", "!~*", "REGEXP", "NOT REGEXP":
+		return true
+	}
+	return false
+}
+
 func IsMathematicalOperator(s string) bool {
 	switch s {
 	case "+":
@@ -41,3 +51,88 @@ func IsMathematicalOperator(s string) bool {
 	}
 	return true
 }
+
+func IsConcatOperator(s string) bool {
+	return s == "||"
+}
+
+// smallint, integer and bigint widen to numeric, which widens to the
+// floating point types. A wider operand decides the type of the result.
+var numericRank = map[string]int{
+	"tinyint":          1,
+	"smallint":         1,
+	"int2":             1,
+	"smallserial":      1,
+	"mediumint":        2,
+	"int":              2,
+	"integer":          2,
+	"int4":             2,
+	"serial":           2,
+	"bigint":           3,
+	"int8":             3,
+	"bigserial":        3,
+	"dec":              4,
+	"decimal":          4,
+	"fixed":            4,
+	"numeric":          4,
+	"real":             5,
+	"float4":           5,
+	"float":            6,
+	"double":           6,
+	"double precision": 6,
+	"float8":           6,
+}
+
+var serialTypes = map[string]string{
+	"smallserial": "smallint",
+	"serial":      "integer",
+	"bigserial":   "bigint",
+}
+
+func baseType(t string) string {
+	return strings.TrimPrefix(t, "pg_catalog.")
+}
+
+func IsNumericType(t string) bool {
+	_, ok := numericRank[baseType(t)]
+	return ok
+}
+
+// ConvertsTo reports whether a value of type from is implicitly converted to
+// type to when it is passed to an operator. Numeric types widen, and the
+// character types convert to text.
+func ConvertsTo(from, to string) bool {
+	switch {
+	case SameType(from, to):
+		return true
+	case IsNumericType(from) && IsNumericType(to):
+		return numericRank[baseType(to)] >= numericRank[baseType(from)]
+	case canonicalType(to) == "text":
+		switch canonicalType(from) {
+		case "character varying", "character", "name":
+			return true
+		}
+	}
+	return false
+}
+
+// CommonType returns the type which values of both types are converted to
+// when they are combined, as in the branches of a CASE expression. An
+// unknown type, given as the empty string, defers to the other type.
+func CommonType(a, b string) string {
+	switch {
+	case a == "":
+		return b
+	case b == "":
+		return a
+	case IsNumericType(a) && IsNumericType(b):
+		if numericRank[baseType(b)] > numericRank[baseType(a)] {
+			a = b
+		}
+		if t, ok := serialTypes[baseType(a)]; ok {
+			return t
+		}
+		return a
+	}
+	return a
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/stephenwithav/sqlc/pkg/config"
+	"github.com/stephenwithav/sqlc/pkg/metadata"
 	"github.com/stephenwithav/sqlc/pkg/source"
 	"github.com/stephenwithav/sqlc/pkg/sql/ast"
 	"github.com/stephenwithav/sqlc/pkg/sql/astutils"
@@ -44,7 +45,7 @@ func isNamedParamSignCast(node ast.Node) bool {
 // paramFromFuncCall creates a param from sqlc.n?arg() calls return the
 // parameter and whether the parameter name was specified a best guess as its
 // "source" string representation (used for replacing this function call in the
-// original SQL query)
+// original SQL query when its exact text cannot be found)
 func paramFromFuncCall(call *ast.FuncCall) (named.Param, string) {
 	paramName, isConst := flatten(call.Args)
 
@@ -59,22 +60,39 @@ func paramFromFuncCall(call *ast.FuncCall) (named.Param, string) {
 		param = named.NewUserNullableParam(paramName)
 	}
 
-	// TODO: This code assumes that sqlc.arg(name) / sqlc.narg(name) is on a single line
-	// with no extraneous spaces (or any non-significant tokens for that matter)
 	origText := fmt.Sprintf("%s.%s(%s)", call.Func.Schema, call.Func.Name, origName)
 	return param, origText
 }
 
-func NamedParameters(engine config.Engine, raw *ast.RawStmt, numbs map[int]bool, dollar bool) (*ast.RawStmt, *named.ParamSet, []source.Edit) {
+// placeholder returns the positional parameter a named one is replaced by.
+// MySQL and SQLite number each ? by its position, so every occurrence of a
+// named parameter is bound on its own.
+func placeholder(engine config.Engine, dollar bool, argn int) string {
+	if engine == config.EngineMySQL || engine == config.EngineSQLite || !dollar {
+		return "?"
+	}
+	return fmt.Sprintf("$%d", argn)
+}
+
+// NamedParameters replaces the named parameters in a statement with
+// positional ones. The returned edits make the same change to sql, the text
+// of the statement.
+func NamedParameters(engine config.Engine, raw *ast.RawStmt, sql string, numbs map[int]bool, dollar bool) (*ast.RawStmt, *named.ParamSet, []source.Edit) {
 	foundFunc := astutils.Search(raw, named.IsParamFunc)
 	foundSign := astutils.Search(raw, named.IsParamSign)
-	hasNamedParameterSupport := engine != config.EngineMySQL
+	hasNamedParameterSupport := engine != config.EngineMySQL && engine != config.EngineSQLite
 	allParams := named.NewParamSet(numbs, hasNamedParameterSupport)
 
 	if len(foundFunc.Items)+len(foundSign.Items) == 0 {
 		return raw, allParams, nil
 	}
 
+	cs := metadata.CommentSyntax{
+		Dash:      true,
+		Hash:      engine == config.EngineMySQL,
+		SlashStar: true,
+	}
+
 	var edits []source.Edit
 	node := astutils.Apply(raw, func(cr *astutils.Cursor) bool {
 		node := cr.Node()
@@ -88,15 +106,14 @@ func NamedParameters(engine config.Engine, raw *ast.RawStmt, numbs map[int]bool,
 				Location: fun.Location,
 			})
 
-			var replace string
-			if engine == config.EngineMySQL || !dollar {
-				replace = "?"
-			} else {
-				replace = fmt.Sprintf("$%d", argn)
-			}
+			replace := placeholder(engine, dollar, argn)
 
+			loc := fun.Location - raw.StmtLocation
+			if text, ok := source.ParamFunc(sql, loc, cs); ok {
+				origText = text
+			}
 			edits = append(edits, source.Edit{
-				Location: fun.Location - raw.StmtLocation,
+				Location: loc,
 				Old:      origText,
 				New:      replace,
 			})
@@ -115,17 +132,16 @@ func NamedParameters(engine config.Engine, raw *ast.RawStmt, numbs map[int]bool,
 			}
 			cr.Replace(cast)
 
-			// TODO: This code assumes that @foo::bool is on a single line
-			var replace string
-			if engine == config.EngineMySQL || !dollar {
-				replace = "?"
-			} else {
-				replace = fmt.Sprintf("$%d", argn)
-			}
+			replace := placeholder(engine, dollar, argn)
 
+			loc := expr.Location - raw.StmtLocation
+			origText, ok := source.ParamSign(sql, loc, cs)
+			if !ok {
+				origText = fmt.Sprintf("@%s", paramName)
+			}
 			edits = append(edits, source.Edit{
-				Location: expr.Location - raw.StmtLocation,
-				Old:      fmt.Sprintf("@%s", paramName),
+				Location: loc,
+				Old:      origText,
 				New:      replace,
 			})
 			return false
@@ -141,17 +157,16 @@ func NamedParameters(engine config.Engine, raw *ast.RawStmt, numbs map[int]bool,
 				Location: expr.Location,
 			})
 
-			// TODO: This code assumes that @foo is on a single line
-			var replace string
-			if engine == config.EngineMySQL || !dollar {
-				replace = "?"
-			} else {
-				replace = fmt.Sprintf("$%d", argn)
-			}
+			replace := placeholder(engine, dollar, argn)
 
+			loc := expr.Location - raw.StmtLocation
+			origText, ok := source.ParamSign(sql, loc, cs)
+			if !ok {
+				origText = fmt.Sprintf("@%s", paramName)
+			}
 			edits = append(edits, source.Edit{
-				Location: expr.Location - raw.StmtLocation,
-				Old:      fmt.Sprintf("@%s", paramName),
+				Location: loc,
+				Old:      origText,
 				New:      replace,
 			})
 			return false
@@ -0,0 +1,148 @@
+package rewrite
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/stephenwithav/sqlc/pkg/config"
+	"github.com/stephenwithav/sqlc/pkg/engine/dolphin"
+	"github.com/stephenwithav/sqlc/pkg/engine/postgresql"
+	"github.com/stephenwithav/sqlc/pkg/engine/sqlite"
+	"github.com/stephenwithav/sqlc/pkg/source"
+	"github.com/stephenwithav/sqlc/pkg/sql/ast"
+	"github.com/stephenwithav/sqlc/pkg/sql/named"
+	"github.com/stephenwithav/sqlc/pkg/sql/validate"
+)
+
+func TestNamedParameters(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		engine config.Engine
+		in     string
+		out    string
+		params []string
+	}{
+		{
+			name:   "postgresql single line",
+			engine: config.EnginePostgreSQL,
+			in:     "SELECT * FROM authors WHERE id = sqlc.arg(id) AND name = @name",
+			out:    "SELECT * FROM authors WHERE id = $1 AND name = $2",
+			params: []string{"id", "name"},
+		},
+		{
+			name:   "postgresql line breaks",
+			engine: config.EnginePostgreSQL,
+			in:     "SELECT * FROM authors\nWHERE id = sqlc.arg(\n  id\n)\nAND name = sqlc . narg ( 'name' )",
+			out:    "SELECT * FROM authors\nWHERE id = $1\nAND name = $2",
+			params: []string{"id", "name"},
+		},
+		{
+			name:   "postgresql comments",
+			engine: config.EnginePostgreSQL,
+			in:     "SELECT * FROM authors WHERE id = sqlc.arg( -- the id\n id /* ) */ ) AND bio = @ bio",
+			out:    "SELECT * FROM authors WHERE id = $1 AND bio = $2",
+			params: []string{"id", "bio"},
+		},
+		{
+			name:   "postgresql nested",
+			engine: config.EnginePostgreSQL,
+			in:     "SELECT coalesce(sqlc.narg(name), (SELECT name FROM authors WHERE id = sqlc.arg(id)))::text, CASE WHEN @flag::bool THEN 1 END",
+			out:    "SELECT coalesce($1, (SELECT name FROM authors WHERE id = $2))::text, CASE WHEN $3::bool THEN 1 END",
+			params: []string{"name", "id", "flag"},
+		},
+		{
+			name:   "postgresql mixed",
+			engine: config.EnginePostgreSQL,
+			in:     "UPDATE authors SET name = $1, bio = sqlc.arg(\n\tbio\n) WHERE id = $2 AND name <> sqlc.arg(bio)",
+			out:    "UPDATE authors SET name = $1, bio = $3 WHERE id = $2 AND name <> $3",
+			params: []string{"", "", "bio"},
+		},
+		{
+			name:   "mysql line breaks and comments",
+			engine: config.EngineMySQL,
+			in:     "SELECT * FROM authors\nWHERE id = sqlc.arg(\n  id # the id\n)\nAND name LIKE CONCAT('%', sqlc.narg( /* c */ 'name' ))",
+			out:    "SELECT * FROM authors\nWHERE id = ?\nAND name LIKE CONCAT('%', ?)",
+			params: []string{"id", "name"},
+		},
+		{
+			name:   "mysql mixed",
+			engine: config.EngineMySQL,
+			in:     "UPDATE authors SET name = ?, bio = sqlc.arg(bio) WHERE id = sqlc.arg(\nid\n)",
+			out:    "UPDATE authors SET name = ?, bio = ? WHERE id = ?",
+			params: []string{"", "bio", "id"},
+		},
+		{
+			name:   "sqlite line breaks and comments",
+			engine: config.EngineSQLite,
+			in:     "SELECT * FROM authors\nWHERE id = sqlc.arg(\n  id -- the id\n)\nAND name = sqlc.narg( /* c */ name )",
+			out:    "SELECT * FROM authors\nWHERE id = ?\nAND name = ?",
+			params: []string{"id", "name"},
+		},
+		{
+			name:   "sqlite repeated",
+			engine: config.EngineSQLite,
+			in:     "SELECT * FROM authors WHERE id = sqlc.arg(id) OR parent_id = sqlc.arg(id)",
+			out:    "SELECT * FROM authors WHERE id = ? OR parent_id = ?",
+			params: []string{"id", "id"},
+		},
+		{
+			name:   "sqlite quoted",
+			engine: config.EngineSQLite,
+			in:     "SELECT * FROM authors WHERE id = sqlc.arg('id') AND name = sqlc.narg('name')",
+			out:    "SELECT * FROM authors WHERE id = ? AND name = ?",
+			params: []string{"id", "name"},
+		},
+		{
+			name:   "sqlite mixed",
+			engine: config.EngineSQLite,
+			in:     "UPDATE authors SET name = ?, bio = upper(sqlc.arg(bio)) WHERE id = sqlc.arg(\nid\n)",
+			out:    "UPDATE authors SET name = ?, bio = upper(?) WHERE id = ?",
+			params: []string{"", "bio", "id"},
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			src := tc.in + ";"
+			var stmts []ast.Statement
+			var err error
+			switch tc.engine {
+			case config.EngineMySQL:
+				stmts, err = dolphin.NewParser().Parse(strings.NewReader(src))
+			case config.EngineSQLite:
+				stmts, err = sqlite.NewParser().Parse(strings.NewReader(src))
+			default:
+				stmts, err = postgresql.NewParser().Parse(strings.NewReader(src))
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			raw := stmts[0].Raw
+			numbers, dollar, err := validate.ParamRef(raw)
+			if err != nil {
+				t.Fatal(err)
+			}
+			sql, err := source.Pluck(src, raw.StmtLocation, raw.StmtLen)
+			if err != nil {
+				t.Fatal(err)
+			}
+			_, params, edits := NamedParameters(tc.engine, raw, sql, numbers, dollar)
+			out, err := source.Mutate(sql, edits)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.out, strings.TrimSuffix(out, ";")); diff != "" {
+				t.Errorf("rewrite differs (-want +got):\n%s", diff)
+			}
+			var names []string
+			for i := 1; i <= len(tc.params); i++ {
+				p, _ := params.FetchMerge(i, named.NewParam(""))
+				names = append(names, p.Name())
+			}
+			if diff := cmp.Diff(tc.params, names); diff != "" {
+				t.Errorf("parameters differ (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -103,6 +103,14 @@ func FunctionNotFound(fun string) *Error {
 	}
 }
 
+func OperatorNotFound(op string) *Error {
+	return &Error{
+		Err:     NotFound,
+		Code:    "42883",
+		Message: fmt.Sprintf("operator \"%s\"", op),
+	}
+}
+
 func FunctionNotUnique(fn string) *Error {
 	return &Error{
 		Err:     NotUnique,
@@ -22,6 +22,20 @@ func (v *funcCallVisitor) Visit(node ast.Node) astutils.Visitor {
 		return nil
 	}
 
+	// A procedure is passed its OUT arguments as well as its input arguments
+	if stmt, ok := node.(*ast.CallStmt); ok && stmt.FuncCall != nil && stmt.FuncCall.Func != nil {
+		if _, err := v.catalog.ResolveCall(stmt.FuncCall); err != nil {
+			if !errors.Is(err, sqlerr.NotFound) || v.settings.Package.StrictFunctionChecks {
+				v.err = err
+				return nil
+			}
+		}
+		if stmt.FuncCall.Args != nil {
+			astutils.Walk(v, stmt.FuncCall.Args)
+		}
+		return nil
+	}
+
 	call, ok := node.(*ast.FuncCall)
 	if !ok {
 		return v
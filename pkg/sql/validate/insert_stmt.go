@@ -1,11 +1,16 @@
 package validate
 
 import (
+	"fmt"
+
 	"github.com/stephenwithav/sqlc/pkg/sql/ast"
 	"github.com/stephenwithav/sqlc/pkg/sql/sqlerr"
 )
 
 func InsertStmt(stmt *ast.InsertStmt) error {
+	if err := onConflict(stmt.OnConflictClause); err != nil {
+		return err
+	}
 	sel, ok := stmt.SelectStmt.(*ast.SelectStmt)
 	if !ok {
 		return nil
@@ -37,3 +42,27 @@ func InsertStmt(stmt *ast.InsertStmt) error {
 	}
 	return nil
 }
+
+// onConflict returns an error if the update of an upsert assigns to a
+// column more than once.
+func onConflict(clause *ast.OnConflictClause) error {
+	if clause == nil || clause.TargetList == nil {
+		return nil
+	}
+	seen := map[string]bool{}
+	for _, item := range clause.TargetList.Items {
+		target, ok := item.(*ast.ResTarget)
+		if !ok || target.Name == nil {
+			continue
+		}
+		if seen[*target.Name] {
+			return &sqlerr.Error{
+				Code:     "42601",
+				Message:  fmt.Sprintf("multiple assignments to same column \"%s\"", *target.Name),
+				Location: target.Location,
+			}
+		}
+		seen[*target.Name] = true
+	}
+	return nil
+}
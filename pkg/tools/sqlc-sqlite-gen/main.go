@@ -0,0 +1,206 @@
+package main
+
+import (
+	"bytes"
+	"database/sql"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+// The math functions are only compiled into the bundled library with the
+// sqlite_math_functions build tag:
+//
+//	go run -tags sqlite_math_functions ./pkg/tools/sqlc-sqlite-gen
+const builtinFuncs = `
+SELECT DISTINCT name, narg
+FROM pragma_function_list
+WHERE builtin
+-- simply order all columns to keep subsequent runs stable
+ORDER BY 1, 2;
+`
+
+const catalogTmpl = `
+// Code generated by sqlc-sqlite-gen. DO NOT EDIT.
+
+package sqlite
+
+import (
+	"github.com/stephenwithav/sqlc/pkg/sql/ast"
+	"github.com/stephenwithav/sqlc/pkg/sql/catalog"
+)
+
+// sqlite {{.Version}} functions from:
+// 		 https://www.sqlite.org/lang_aggfunc.html
+// 		 https://www.sqlite.org/lang_mathfunc.html
+//		 https://www.sqlite.org/lang_corefunc.html
+//		 https://www.sqlite.org/lang_datefunc.html
+// 		 https://www.sqlite.org/windowfunctions.html
+// 		 https://www.sqlite.org/json1.html
+
+var funcsStdlib = []*catalog.Function{
+	{{- range .Procs}}
+	{
+		Name: "{{.Name}}",
+		Args: []*catalog.Argument{
+			{{range .Args}}{
+			Type: &ast.TypeName{Name: "{{.Type}}"},
+			{{- if .Variadic}}
+			Mode: ast.FuncParamVariadic,
+			{{- end}}
+			},
+			{{end}}
+		},
+		ReturnType: &ast.TypeName{Name: "{{.ReturnType}}"},
+		{{- if .Nullable}}
+		ReturnTypeNullable: true,
+		{{- end}}
+	},
+	{{- end}}
+}
+`
+
+type Proc struct {
+	Name       string
+	Args       []Arg
+	ReturnType string
+	Nullable   bool
+}
+
+type Arg struct {
+	Type     string
+	Variadic bool
+}
+
+type tmplCtx struct {
+	Version string
+	Procs   []Proc
+}
+
+func main() {
+	if err := run(); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// writeFormattedGo executes `tmpl` with `data` as its context to the the file `destPath`
+func writeFormattedGo(tmpl *template.Template, data any, destPath string) error {
+	out := bytes.NewBuffer([]byte{})
+	err := tmpl.Execute(out, data)
+	if err != nil {
+		return err
+	}
+	code, err := format.Source(out.Bytes())
+	if err != nil {
+		return err
+	}
+
+	err = os.WriteFile(destPath, code, 0644)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func run() error {
+	flag.Parse()
+
+	dir := flag.Arg(0)
+	if dir == "" {
+		dir = filepath.Join("pkg", "engine", "sqlite")
+	}
+
+	tmpl, err := template.New("").Parse(catalogTmpl)
+	if err != nil {
+		return err
+	}
+	db, err := sql.Open("sqlite3", ":memory:")
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	var version string
+	if err := db.QueryRow("SELECT sqlite_version()").Scan(&version); err != nil {
+		return err
+	}
+
+	rows, err := db.Query(builtinFuncs)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	var procs []Proc
+	seen := map[string]bool{}
+	for rows.Next() {
+		var name string
+		var narg int
+		if err := rows.Scan(&name, &narg); err != nil {
+			return err
+		}
+		if skip(name) {
+			continue
+		}
+		sigs := overloads[name]
+		if sig, ok := signatures[name]; ok {
+			sigs = append(sigs, sig)
+		}
+		if len(sigs) == 0 {
+			return fmt.Errorf("no signature for function %s", name)
+		}
+		for _, sig := range sigs {
+			proc, err := sig.proc(name, narg)
+			if err != nil {
+				return err
+			}
+			procs = append(procs, proc)
+		}
+		seen[name] = true
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	if !seen["sqrt"] {
+		return fmt.Errorf("math functions are missing; build with -tags sqlite_math_functions")
+	}
+	for name := range signatures {
+		if !seen[name] {
+			return fmt.Errorf("function %s is not in SQLite %s", name, version)
+		}
+	}
+	for name := range overloads {
+		if !seen[name] {
+			return fmt.Errorf("function %s is not in SQLite %s", name, version)
+		}
+	}
+
+	return writeFormattedGo(tmpl, tmplCtx{Version: version, Procs: procs}, filepath.Join(dir, "stdlib.go"))
+}
+
+// skip reports whether a function is left out of the catalog. Operators are
+// typed by the compiler, and the functions of extensions which aren't always
+// compiled in are declared in extension.go.
+func skip(name string) bool {
+	switch {
+	case name == "->", name == "->>", name == "match":
+		return true
+	case strings.HasPrefix(name, "auth"):
+		return true
+	case strings.HasPrefix(name, "fts3"), strings.HasPrefix(name, "rtree"):
+		return true
+	}
+	switch name {
+	case "matchinfo", "offsets", "optimize", "snippet":
+		return true
+	}
+	return false
+}
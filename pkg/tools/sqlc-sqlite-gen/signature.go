@@ -0,0 +1,224 @@
+package main
+
+import "fmt"
+
+// pragma_function_list gives the number of arguments of each function, but
+// not their types. A signature gives the types of the arguments by
+// position, and the type of any further arguments of a function declared
+// with a variable number of them.
+type signature struct {
+	Args       []string
+	Variadic   string
+	ReturnType string
+	Nullable   bool
+}
+
+func (s signature) proc(name string, narg int) (Proc, error) {
+	p := Proc{
+		Name:       name,
+		ReturnType: s.ReturnType,
+		Nullable:   s.Nullable,
+	}
+	switch {
+	case narg < 0 && s.Variadic == "":
+		return p, fmt.Errorf("no type for the arguments of %s", name)
+	case narg < 0:
+		for _, typ := range s.Args {
+			p.Args = append(p.Args, Arg{Type: typ})
+		}
+		p.Args = append(p.Args, Arg{Type: s.Variadic, Variadic: true})
+	case narg > len(s.Args):
+		return p, fmt.Errorf("no type for argument %d of %s", narg, name)
+	default:
+		for _, typ := range s.Args[:narg] {
+			p.Args = append(p.Args, Arg{Type: typ})
+		}
+	}
+	return p, nil
+}
+
+// A polymorphic anyelement result takes the type of the arguments, so the
+// maximum of integers is an integer.
+var signatures = map[string]signature{
+	// Aggregate Functions
+	"avg":              {Args: []string{"any"}, ReturnType: "real", Nullable: true},
+	"count":            {Args: []string{"any"}, ReturnType: "integer"},
+	"group_concat":     {Args: []string{"any", "text"}, ReturnType: "text"},
+	"json_group_array": {Args: []string{"any"}, ReturnType: "text"},
+	"json_group_object": {
+		Args:       []string{"text", "any"},
+		ReturnType: "text",
+	},
+	"total": {Args: []string{"any"}, ReturnType: "real"},
+
+	// Aggregate and Scalar Functions
+	"max": {
+		Args:       []string{"anyelement", "anyelement"},
+		Variadic:   "anyelement",
+		ReturnType: "anyelement",
+		Nullable:   true,
+	},
+	"min": {
+		Args:       []string{"anyelement", "anyelement"},
+		Variadic:   "anyelement",
+		ReturnType: "anyelement",
+		Nullable:   true,
+	},
+
+	// Window Functions
+	"cume_dist":  {ReturnType: "real"},
+	"dense_rank": {ReturnType: "integer"},
+	"first_value": {
+		Args:       []string{"anyelement"},
+		ReturnType: "anyelement",
+		Nullable:   true,
+	},
+	"lag": {
+		Args:       []string{"anyelement", "integer", "anyelement"},
+		ReturnType: "anyelement",
+		Nullable:   true,
+	},
+	"last_value": {
+		Args:       []string{"anyelement"},
+		ReturnType: "anyelement",
+		Nullable:   true,
+	},
+	"lead": {
+		Args:       []string{"anyelement", "integer", "anyelement"},
+		ReturnType: "anyelement",
+		Nullable:   true,
+	},
+	"nth_value": {
+		Args:       []string{"anyelement", "integer"},
+		ReturnType: "anyelement",
+		Nullable:   true,
+	},
+	"ntile":        {Args: []string{"integer"}, ReturnType: "integer"},
+	"percent_rank": {ReturnType: "real"},
+	"rank":         {ReturnType: "integer"},
+	"row_number":   {ReturnType: "integer"},
+
+	// Math Functions
+	"acos":    {Args: []string{"real"}, ReturnType: "real"},
+	"acosh":   {Args: []string{"real"}, ReturnType: "real"},
+	"asin":    {Args: []string{"real"}, ReturnType: "real"},
+	"asinh":   {Args: []string{"real"}, ReturnType: "real"},
+	"atan":    {Args: []string{"real"}, ReturnType: "real"},
+	"atan2":   {Args: []string{"real", "real"}, ReturnType: "real"},
+	"atanh":   {Args: []string{"real"}, ReturnType: "real"},
+	"ceil":    {Args: []string{"real"}, ReturnType: "integer"},
+	"ceiling": {Args: []string{"real"}, ReturnType: "integer"},
+	"cos":     {Args: []string{"real"}, ReturnType: "real"},
+	"cosh":    {Args: []string{"real"}, ReturnType: "real"},
+	"degrees": {Args: []string{"real"}, ReturnType: "real"},
+	"exp":     {Args: []string{"real"}, ReturnType: "real"},
+	"floor":   {Args: []string{"real"}, ReturnType: "integer"},
+	"ln":      {Args: []string{"real"}, ReturnType: "real"},
+	"log":     {Args: []string{"real", "real"}, ReturnType: "real"},
+	"log10":   {Args: []string{"real"}, ReturnType: "real"},
+	"log2":    {Args: []string{"real"}, ReturnType: "real"},
+	"mod":     {Args: []string{"real", "real"}, ReturnType: "real"},
+	"pi":      {ReturnType: "real"},
+	"pow":     {Args: []string{"real", "real"}, ReturnType: "real"},
+	"power":   {Args: []string{"real", "real"}, ReturnType: "real"},
+	"radians": {Args: []string{"real"}, ReturnType: "real"},
+	"sin":     {Args: []string{"real"}, ReturnType: "real"},
+	"sinh":    {Args: []string{"real"}, ReturnType: "real"},
+	"sqrt":    {Args: []string{"real"}, ReturnType: "real"},
+	"tan":     {Args: []string{"real"}, ReturnType: "real"},
+	"tanh":    {Args: []string{"real"}, ReturnType: "real"},
+	"trunc":   {Args: []string{"real"}, ReturnType: "integer"},
+
+	// Core Functions
+	"abs":     {Args: []string{"anyelement"}, ReturnType: "anyelement"},
+	"changes": {ReturnType: "integer"},
+	"char":    {Variadic: "integer", ReturnType: "text"},
+	"coalesce": {
+		Args:       []string{"any", "any"},
+		Variadic:   "any",
+		ReturnType: "any",
+		Nullable:   true,
+	},
+	"format":            {Args: []string{"text"}, Variadic: "any", ReturnType: "text", Nullable: true},
+	"glob":              {Args: []string{"text", "text"}, ReturnType: "integer"},
+	"hex":               {Args: []string{"any"}, ReturnType: "text"},
+	"ifnull":            {Args: []string{"any", "any"}, ReturnType: "any", Nullable: true},
+	"iif":               {Args: []string{"any", "any", "any"}, ReturnType: "any", Nullable: true},
+	"instr":             {Args: []string{"text", "text"}, ReturnType: "integer", Nullable: true},
+	"last_insert_rowid": {ReturnType: "integer"},
+	"length":            {Args: []string{"any"}, ReturnType: "integer", Nullable: true},
+	"like":              {Args: []string{"text", "text", "text"}, ReturnType: "integer"},
+	"likelihood":        {Args: []string{"any", "real"}, ReturnType: "any", Nullable: true},
+	"likely":            {Args: []string{"any"}, ReturnType: "any", Nullable: true},
+	"load_extension":    {Args: []string{"text", "text"}, ReturnType: "any", Nullable: true},
+	"lower":             {Args: []string{"text"}, ReturnType: "text"},
+	"ltrim":             {Args: []string{"text", "text"}, ReturnType: "text"},
+	"nullif":            {Args: []string{"any", "any"}, ReturnType: "any", Nullable: true},
+	"printf":            {Args: []string{"text"}, Variadic: "any", ReturnType: "text", Nullable: true},
+	"quote":             {Args: []string{"any"}, ReturnType: "text"},
+	"random":            {ReturnType: "integer"},
+	"randomblob":        {Args: []string{"integer"}, ReturnType: "blob"},
+	"replace":           {Args: []string{"text", "text", "text"}, ReturnType: "text"},
+	"round":             {Args: []string{"real", "integer"}, ReturnType: "real"},
+	"rtrim":             {Args: []string{"text", "text"}, ReturnType: "text"},
+	"sign":              {Args: []string{"real"}, ReturnType: "integer", Nullable: true},
+	"sqlite_compileoption_get": {
+		Args:       []string{"integer"},
+		ReturnType: "text",
+		Nullable:   true,
+	},
+	"sqlite_compileoption_used": {
+		Args:       []string{"text"},
+		ReturnType: "integer",
+	},
+	"sqlite_log":       {Args: []string{"integer", "text"}, ReturnType: "any", Nullable: true},
+	"sqlite_source_id": {ReturnType: "text"},
+	"sqlite_version":   {ReturnType: "text"},
+	"substr":           {Args: []string{"any", "integer", "integer"}, ReturnType: "text"},
+	"substring":        {Args: []string{"any", "integer", "integer"}, ReturnType: "text"},
+	"subtype":          {Args: []string{"any"}, ReturnType: "integer"},
+	"total_changes":    {ReturnType: "integer"},
+	"trim":             {Args: []string{"text", "text"}, ReturnType: "text"},
+	"typeof":           {Args: []string{"any"}, ReturnType: "text"},
+	"unicode":          {Args: []string{"text"}, ReturnType: "integer"},
+	"unlikely":         {Args: []string{"any"}, ReturnType: "any", Nullable: true},
+	"upper":            {Args: []string{"text"}, ReturnType: "text"},
+	"zeroblob":         {Args: []string{"integer"}, ReturnType: "blob"},
+
+	// Date And Time Functions
+	"current_date":      {ReturnType: "text"},
+	"current_time":      {ReturnType: "text"},
+	"current_timestamp": {ReturnType: "text"},
+	"date":              {Variadic: "any", ReturnType: "text", Nullable: true},
+	"datetime":          {Variadic: "any", ReturnType: "text", Nullable: true},
+	"julianday":         {Variadic: "any", ReturnType: "real", Nullable: true},
+	"strftime":          {Args: []string{"text"}, Variadic: "any", ReturnType: "text", Nullable: true},
+	"time":              {Variadic: "any", ReturnType: "text", Nullable: true},
+	"unixepoch":         {Variadic: "any", ReturnType: "integer", Nullable: true},
+
+	// JSON Functions
+	"json":              {Args: []string{"text"}, ReturnType: "text"},
+	"json_array":        {Variadic: "any", ReturnType: "text"},
+	"json_array_length": {Args: []string{"text", "text"}, ReturnType: "integer", Nullable: true},
+	"json_extract":      {Args: []string{"text"}, Variadic: "text", ReturnType: "any", Nullable: true},
+	"json_insert":       {Args: []string{"text"}, Variadic: "any", ReturnType: "text", Nullable: true},
+	"json_object":       {Variadic: "any", ReturnType: "text"},
+	"json_patch":        {Args: []string{"text", "text"}, ReturnType: "text", Nullable: true},
+	"json_quote":        {Args: []string{"any"}, ReturnType: "text"},
+	"json_remove":       {Args: []string{"text"}, Variadic: "text", ReturnType: "text", Nullable: true},
+	"json_replace":      {Args: []string{"text"}, Variadic: "any", ReturnType: "text", Nullable: true},
+	"json_set":          {Args: []string{"text"}, Variadic: "any", ReturnType: "text", Nullable: true},
+	"json_type":         {Args: []string{"text", "text"}, ReturnType: "text", Nullable: true},
+	"json_valid":        {Args: []string{"text"}, ReturnType: "integer"},
+}
+
+// overloads are the signatures of functions whose result depends on the
+// types of their arguments in a way anyelement can't express. The first
+// overload is used when the types of the arguments are unknown.
+var overloads = map[string][]signature{
+	// The sum of integers is an integer, and of anything else a real
+	"sum": {
+		{Args: []string{"any"}, ReturnType: "real", Nullable: true},
+		{Args: []string{"integer"}, ReturnType: "integer", Nullable: true},
+	},
+}